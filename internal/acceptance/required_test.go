@@ -44,17 +44,17 @@ func TestAccEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 	requiredResourceProviders := resourceproviders.Required()
 	subscriptionId := commonids.NewSubscriptionID(armClient.Account.SubscriptionId)
 
-	if err = resourceproviders.EnsureRegistered(ctx, client, subscriptionId, requiredResourceProviders); err != nil {
+	if err = resourceproviders.EnsureRegistered(ctx, client, subscriptionId, requiredResourceProviders, nil); err != nil {
 		t.Fatalf("Error registering Resource Providers: %+v", err)
 	}
 
 	// refresh the cache now things have been re-registered
 	resourceproviders.ClearCache()
-	if err := resourceproviders.CacheSupportedProviders(ctx, client, subscriptionId); err != nil {
+	if err := resourceproviders.CacheSupportedProviders(ctx, client, subscriptionId, nil); err != nil {
 		t.Fatalf("re-caching Resource Providers: %+v", err)
 	}
 
-	stillRequiringRegistration, err := resourceproviders.DetermineWhichRequiredResourceProvidersRequireRegistration(requiredResourceProviders)
+	stillRequiringRegistration, err := resourceproviders.DetermineWhichRequiredResourceProvidersRequireRegistration(subscriptionId, requiredResourceProviders)
 	if err != nil {
 		t.Fatalf("determining which Resource Providers still require Registration: %+v", err)
 	}
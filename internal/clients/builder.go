@@ -11,28 +11,59 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2015-04-01/activitylogs"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/drift"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 )
 
 type ClientBuilder struct {
-	AuthConfig *auth.Credentials
-	Features   features.UserFeatures
+	AuthConfig  *auth.Credentials
+	DefaultTags map[string]string
+	Features    features.UserFeatures
 
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 
+	IgnoredTagKeys        []string
+	IgnoredTagKeyPrefixes []string
+
+	// MaxConcurrentWrites and MinimumRequestHeadroom configure pacing the requests made to Resource Manager
+	MaxConcurrentWrites    int
+	MinimumRequestHeadroom int
+
+	// ReportDrift raises a warning when a resource has changed outside of Terraform, and ReportDriftActivityLog
+	// looks up who made the change from the Activity Log
+	ReportDrift            bool
+	ReportDriftActivityLog bool
+
+	// Retry configures retrying requests which fail with a transient error, requests aren't retried when nil
+	Retry *common.RetryOptions
+
+	// ResourceProviderCache caches the Resource Providers available in the Subscription on disk, when configured
+	ResourceProviderCache *resourceproviders.DiskCache
+
+	// RegisterResourceProvidersOnDemand allows the (autorest) clients to register a Resource Provider the first time it's
+	// used, should it not be registered - this is only enabled when every Resource Provider is to be registered, since
+	// otherwise this would register Resource Providers outside of the selected set
+	RegisterResourceProvidersOnDemand bool
+
 	CustomCorrelationRequestID string
+	HttpTraceFilePath          string
 	MetadataHost               string
 	PartnerID                  string
 	SubscriptionID             string
 	TerraformVersion           string
+
+	// RecordingMode and RecordingPath configure recording HTTP interactions to, or replaying them from, a cassette
+	RecordingMode string
+	RecordingPath string
 }
 
 const azureStackEnvironmentError = `
@@ -52,25 +83,38 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf(azureStackEnvironmentError)
 	}
 
+	recorder, err := common.NewHttpRecorder(builder.RecordingMode, builder.RecordingPath)
+	if err != nil {
+		return nil, fmt.Errorf("configuring HTTP recording: %+v", err)
+	}
+	replaying := recorder.IsReplaying()
+
+	tracer, err := common.NewHttpTracer(builder.HttpTraceFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("configuring HTTP tracing: %+v", err)
+	}
+
+	rateLimiter := common.NewRateLimiter(builder.MaxConcurrentWrites, builder.MinimumRequestHeadroom)
+
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager, replaying)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage, replaying)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault, replaying)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse, replaying)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -79,7 +123,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch, replaying)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -89,7 +133,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(ctx, *builder.AuthConfig, api, replaying)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -97,14 +141,19 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return authorizer, nil
 	})
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.SkipProviderRegistration)
+	var account *ResourceManagerAccount
+	if replaying {
+		account, err = newReplayResourceManagerAccount(*builder.AuthConfig, builder.SubscriptionID, builder.SkipProviderRegistration)
+	} else {
+		account, err = NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.SkipProviderRegistration)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM, replaying)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -148,26 +197,43 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		SynapseAuthorizer:         authWrapper.AutorestAuthorizer(synapseAuth),
 
 		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
+		DefaultTags:                 builder.DefaultTags,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
-		SkipProviderReg:             builder.SkipProviderRegistration,
+		IgnoredTagKeys:              builder.IgnoredTagKeys,
+		IgnoredTagKeyPrefixes:       builder.IgnoredTagKeyPrefixes,
+		SkipProviderReg:             builder.SkipProviderRegistration || !builder.RegisterResourceProvidersOnDemand,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
+
+		RateLimiter: rateLimiter,
+		RetryPolicy: common.NewRetryPolicy(builder.Retry),
+		Recorder:    recorder,
+		Tracer:      tracer,
 	}
 
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
 
-	if features.EnhancedValidationEnabled() {
+	if builder.ReportDrift {
+		var activityLogsClient *activitylogs.ActivityLogsClient
+		if builder.ReportDriftActivityLog {
+			activityLogsClient = client.Monitor.ActivityLogsClient
+		}
+		client.DriftReporter = drift.NewReporter(activityLogsClient)
+	}
+
+	// the supported locations are retrieved outside of the configured clients, so can't be replayed
+	if features.EnhancedValidationEnabled() && !replaying {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
 
 		ctx2, cancel := context.WithTimeout(ctx, 10*time.Minute)
 		defer cancel()
 
 		location.CacheSupportedLocations(ctx2, *resourceManagerEndpoint)
-		if err := resourceproviders.CacheSupportedProviders(ctx2, client.Resource.ResourceProvidersClient, subscriptionId); err != nil {
+		if err := resourceproviders.CacheSupportedProviders(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, builder.ResourceProviderCache); err != nil {
 			log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
 		}
 	}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"golang.org/x/oauth2"
+)
+
+var _ auth.Authorizer = replayAuthorizer{}
+
+// replayAuthorizer is a stub auth.Authorizer used when replaying HTTP interactions from a cassette,
+// since no requests are sent to Azure and as such no credentials are required
+type replayAuthorizer struct{}
+
+func (replayAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: "replay",
+		TokenType:   "Bearer",
+	}, nil
+}
+
+func (replayAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return []*oauth2.Token{}, nil
+}
+
+// newAuthorizer builds an auth.Authorizer for the specified API, using a stub authorizer when replaying
+func newAuthorizer(ctx context.Context, config auth.Credentials, api environments.Api, replaying bool) (auth.Authorizer, error) {
+	if replaying {
+		return replayAuthorizer{}, nil
+	}
+
+	return auth.NewAuthorizerFromCredentials(ctx, config, api)
+}
+
+// newReplayResourceManagerAccount builds a ResourceManagerAccount from the Provider configuration alone, since
+// there's no access token to parse the claims from when replaying
+func newReplayResourceManagerAccount(config auth.Credentials, subscriptionId string, skipResourceProviderRegistration bool) (*ResourceManagerAccount, error) {
+	if config.TenantID == "" {
+		return nil, fmt.Errorf("unable to configure ResourceManagerAccount: a tenant ID must be specified when replaying")
+	}
+	if subscriptionId == "" {
+		return nil, fmt.Errorf("unable to configure ResourceManagerAccount: a subscription ID must be specified when replaying")
+	}
+
+	return &ResourceManagerAccount{
+		Environment: config.Environment,
+
+		ClientId:       config.ClientID,
+		SubscriptionId: subscriptionId,
+		TenantId:       config.TenantID,
+
+		AuthenticatedAsAServicePrincipal: true,
+		SkipResourceProviderRegistration: skipResourceProviderRegistration,
+	}, nil
+}
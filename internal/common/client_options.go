@@ -46,12 +46,32 @@ type ClientOptions struct {
 	CustomCorrelationRequestID  string
 	DisableCorrelationRequestID bool
 
+	// DefaultTags are merged into the Tags of every resource which supports updating Tags in-place
+	DefaultTags map[string]string
+
+	// IgnoredTagKeys and IgnoredTagKeyPrefixes are Tags managed outside of Terraform, which
+	// are ignored when reading Tags and preserved when updating them
+	IgnoredTagKeys        []string
+	IgnoredTagKeyPrefixes []string
+
 	DisableTerraformPartnerID bool
 	SkipProviderReg           bool
 	StorageUseAzureAD         bool
 
 	ResourceManagerEndpoint string
 
+	// RetryPolicy retries requests which fail with a transient error when configured
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter paces the requests made to Resource Manager for each Subscription when configured
+	RateLimiter *RateLimiter
+
+	// Recorder records HTTP interactions to, or replays them from, a cassette when configured
+	Recorder *HttpRecorder
+
+	// Tracer writes a structured trace of each HTTP exchange to a file when configured
+	Tracer *HttpTracer
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -75,6 +95,36 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+
+	// the request middleware is configured in the reverse order to the response middleware, so that when replaying
+	// the request is redirected to the local replay server last - and the original URL is restored first
+	if o.RetryPolicy != nil {
+		c.AppendRequestMiddleware(o.RetryPolicy.requestMiddleware())
+	}
+	if o.RateLimiter != nil {
+		c.AppendRequestMiddleware(o.RateLimiter.requestMiddleware())
+	}
+	if o.Tracer != nil {
+		c.AppendRequestMiddleware(o.Tracer.requestMiddleware())
+	}
+	if o.Recorder != nil {
+		c.AppendRequestMiddleware(o.Recorder.requestMiddleware())
+	}
+	// the write slot (if any) is released first, since the remaining response middleware is skipped when an
+	// earlier one returns an error
+	if o.RateLimiter != nil {
+		c.AppendResponseMiddleware(o.RateLimiter.responseMiddleware())
+	}
+	if o.Recorder != nil {
+		c.AppendResponseMiddleware(o.Recorder.responseMiddleware())
+	}
+	if o.Tracer != nil {
+		c.AppendResponseMiddleware(o.Tracer.responseMiddleware())
+	}
+	if o.RetryPolicy != nil {
+		// retries are re-sent using this client, so this is appended last - since the response middleware is run for each retry
+		c.AppendResponseMiddleware(o.RetryPolicy.responseMiddleware(c))
+	}
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -83,6 +133,20 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	// each decorator wraps those before it, so that requests re-sent by the RetryPolicy are also paced, traced
+	// and recorded
+	if o.Recorder != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.Recorder.sendDecorator())
+	}
+	if o.Tracer != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.Tracer.sendDecorator())
+	}
+	if o.RateLimiter != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.RateLimiter.sendDecorator())
+	}
+	if o.RetryPolicy != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.RetryPolicy.sendDecorator())
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -14,7 +14,7 @@ import (
 func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
 		// ensure the `X-Correlation-ID` field is set
-		request.Header.Add(HeaderCorrelationRequestID, id)
+		request.Header.Set(HeaderCorrelationRequestID, id)
 		return request, nil
 	}
 }
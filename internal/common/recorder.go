@@ -0,0 +1,495 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const (
+	// RecordingModeRecord records each HTTP request/response pair to the cassette
+	RecordingModeRecord = "record"
+
+	// RecordingModeReplay replays each HTTP response from the cassette, without making any requests to Azure
+	RecordingModeReplay = "replay"
+
+	// headerReplayOriginalHost is used to pass the original host of a request through to the local replay server
+	headerReplayOriginalHost = "X-Terraform-Replay-Original-Host"
+)
+
+// recordedHeadersToStrip contains the headers which should never be written to a cassette
+var recordedHeadersToStrip = []string{
+	"Authorization",
+	"Cookie",
+	"Ocp-Apim-Subscription-Key",
+	"Set-Cookie",
+	"x-functions-key",
+	"x-ms-authorization-auxiliary",
+	"x-ms-copy-source-authorization",
+	"x-ms-encryption-key",
+	headerReplayOriginalHost,
+}
+
+// redactedValue replaces the value of any secret within a request or response body written to a cassette
+const redactedValue = "REDACTED"
+
+// recordedFieldSuffixesToRedact contains the (lower-cased) suffixes of the JSON fields within a request or response
+// body whose values are secret, for example `administratorLoginPassword` or `primaryConnectionString`
+var recordedFieldSuffixesToRedact = []string{
+	"accesskey",
+	"connectionstring",
+	"masterkey",
+	"password",
+	"primarykey",
+	"privatekey",
+	"sastoken",
+	"sasuri",
+	"sasurl",
+	"secondarykey",
+	"secret",
+	"sharedkey",
+	"token",
+}
+
+// recordedPathsWithSecretValues matches the requests whose responses contain a secret in a field named `value`, such
+// as the `listKeys` action for a Storage Account or retrieving a Key Vault Secret
+var recordedPathsWithSecretValues = regexp.MustCompile(`(?i)(/(list|regenerate)[a-z]*(keys?|secrets?|sas|connectionstrings?|credentials?|tokens?)$|^/secrets/)`)
+
+var (
+	httpRecordersLock sync.Mutex
+	httpRecorders     = map[string]*HttpRecorder{}
+)
+
+// HttpRecorder records the HTTP interactions made by the Provider to a cassette file, or replays
+// the responses from a previously recorded cassette file - so that the Provider can be run without
+// access to (or credentials for) Azure.
+type HttpRecorder struct {
+	mode string
+	path string
+
+	lock sync.Mutex
+
+	// used in record mode
+	file *os.File
+
+	// used in replay mode
+	interactions []httpInteraction
+	used         []bool
+	address      string
+}
+
+type httpInteraction struct {
+	Request  httpRecordedRequest  `json:"request"`
+	Response httpRecordedResponse `json:"response"`
+}
+
+type httpRecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type httpRecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// NewHttpRecorder returns the HttpRecorder for the specified mode and cassette path, or nil when recording
+// is disabled. A single HttpRecorder is used for each cassette path for the lifetime of the process, since
+// the Provider can be configured multiple times (e.g. once per step in an acceptance test).
+func NewHttpRecorder(mode, path string) (*HttpRecorder, error) {
+	if mode == "" {
+		return nil, nil
+	}
+
+	if mode != RecordingModeRecord && mode != RecordingModeReplay {
+		return nil, fmt.Errorf("unsupported recording mode %q - expected either %q or %q", mode, RecordingModeRecord, RecordingModeReplay)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("a cassette path must be specified when the recording mode is %q", mode)
+	}
+
+	httpRecordersLock.Lock()
+	defer httpRecordersLock.Unlock()
+
+	if existing, ok := httpRecorders[path]; ok {
+		if existing.mode != mode {
+			return nil, fmt.Errorf("the cassette %q is already in use in %q mode", path, existing.mode)
+		}
+		return existing, nil
+	}
+
+	recorder := &HttpRecorder{
+		mode: mode,
+		path: path,
+	}
+
+	if mode == RecordingModeRecord {
+		// any interactions from a previous recording are discarded, since otherwise these would be replayed
+		// in place of the newly recorded interactions
+		file, err := os.OpenFile(path, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("opening cassette %q: %+v", path, err)
+		}
+		recorder.file = file
+		log.Printf("[DEBUG] Recording HTTP interactions to the cassette %q", path)
+	} else {
+		if err := recorder.load(); err != nil {
+			return nil, err
+		}
+		if err := recorder.serve(); err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Replaying %d HTTP interactions from the cassette %q", len(recorder.interactions), path)
+	}
+
+	httpRecorders[path] = recorder
+	return recorder, nil
+}
+
+// IsReplaying returns whether responses are being replayed from the cassette rather than requested from Azure
+func (r *HttpRecorder) IsReplaying() bool {
+	return r != nil && r.mode == RecordingModeReplay
+}
+
+func (r *HttpRecorder) load() error {
+	file, err := os.Open(r.path)
+	if err != nil {
+		return fmt.Errorf("opening cassette %q: %+v", r.path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var interaction httpInteraction
+		if err := json.Unmarshal(line, &interaction); err != nil {
+			return fmt.Errorf("parsing interaction %d from cassette %q: %+v", len(r.interactions)+1, r.path, err)
+		}
+		r.interactions = append(r.interactions, interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading cassette %q: %+v", r.path, err)
+	}
+
+	r.used = make([]bool, len(r.interactions))
+	return nil
+}
+
+// serve starts a local HTTP server which replays responses for the go-azure-sdk based clients, since
+// these don't support overriding the HTTP transport - and so requests are instead redirected here
+func (r *HttpRecorder) serve() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("starting local replay server: %+v", err)
+	}
+	r.address = listener.Addr().String()
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			req.URL.Scheme = "https"
+			req.URL.Host = req.Header.Get(headerReplayOriginalHost)
+
+			resp, err := r.replay(req)
+			if err != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotImplemented)
+				body, _ := json.Marshal(map[string]interface{}{
+					"error": map[string]string{
+						"code":    "NoRecordedInteraction",
+						"message": err.Error(),
+					},
+				})
+				_, _ = w.Write(body)
+				return
+			}
+			defer resp.Body.Close()
+
+			for k, v := range resp.Header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(resp.StatusCode)
+			_, _ = io.Copy(w, resp.Body)
+		}),
+	}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.Printf("[ERROR] Local replay server stopped: %+v", err)
+		}
+	}()
+
+	return nil
+}
+
+// replay returns the first unused interaction in the cassette matching the method and URL of the request.
+// Since GET requests are idempotent, once all matching interactions have been used the last one is reused.
+func (r *HttpRecorder) replay(req *http.Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	url := req.URL.String()
+	lastMatch := -1
+	for i, interaction := range r.interactions {
+		if !strings.EqualFold(interaction.Request.Method, req.Method) || interaction.Request.URL != url {
+			continue
+		}
+
+		lastMatch = i
+		if !r.used[i] {
+			r.used[i] = true
+			return interaction.Response.toHttpResponse(req), nil
+		}
+	}
+
+	if lastMatch != -1 && strings.EqualFold(req.Method, http.MethodGet) {
+		return r.interactions[lastMatch].Response.toHttpResponse(req), nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction was found in the cassette %q for %s %s", r.path, req.Method, url)
+}
+
+func (r *HttpRecorder) record(req *http.Request, requestBody []byte, resp *http.Response) error {
+	redactValues := recordedPathsWithSecretValues.MatchString(req.URL.Path)
+	interaction := httpInteraction{
+		Request: httpRecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: stripRecordedHeaders(req.Header),
+			Body:    redactRecordedBody(requestBody, redactValues),
+		},
+		Response: httpRecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    stripRecordedHeaders(resp.Header),
+		},
+	}
+
+	if resp.Body != nil {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("reading response body: %+v", err)
+		}
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		interaction.Response.Body = redactRecordedBody(body, redactValues)
+	}
+
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return fmt.Errorf("marshalling interaction: %+v", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, err := r.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing interaction to cassette %q: %+v", r.path, err)
+	}
+
+	return nil
+}
+
+func (r httpRecordedResponse) toHttpResponse(req *http.Request) *http.Response {
+	headers := r.Headers.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+
+	// there's no need to wait between requests when replaying
+	headers.Del("Retry-After")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+func stripRecordedHeaders(input http.Header) http.Header {
+	output := input.Clone()
+	for _, v := range recordedHeadersToStrip {
+		output.Del(v)
+	}
+	return output
+}
+
+// redactRecordedBody replaces the values of any secret fields within a JSON request or response body - including any
+// field named `value` when redactValues is true. Bodies which aren't JSON are returned as-is.
+func redactRecordedBody(input []byte, redactValues bool) string {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	var body interface{}
+	if err := decoder.Decode(&body); err != nil || decoder.More() {
+		return string(input)
+	}
+
+	redacted, changed := redactRecordedValue(body, redactValues)
+	if !changed {
+		return string(input)
+	}
+
+	output, err := json.Marshal(redacted)
+	if err != nil {
+		return string(input)
+	}
+	return string(output)
+}
+
+func redactRecordedValue(input interface{}, redactValues bool) (interface{}, bool) {
+	changed := false
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := value.(string); ok && isRecordedFieldSecret(key, redactValues) {
+				v[key] = redactedValue
+				changed = true
+				continue
+			}
+
+			if redacted, ok := redactRecordedValue(value, redactValues); ok {
+				v[key] = redacted
+				changed = true
+			}
+		}
+
+	case []interface{}:
+		for i, value := range v {
+			if redacted, ok := redactRecordedValue(value, redactValues); ok {
+				v[i] = redacted
+				changed = true
+			}
+		}
+	}
+
+	return input, changed
+}
+
+func isRecordedFieldSecret(key string, redactValues bool) bool {
+	key = strings.ToLower(key)
+	if redactValues && key == "value" {
+		return true
+	}
+
+	for _, suffix := range recordedFieldSuffixesToRedact {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestMiddleware returns a client.RequestMiddleware which either captures the request body so that it can be
+// recorded, or redirects the request to the local replay server
+func (r *HttpRecorder) requestMiddleware() client.RequestMiddleware {
+	return func(req *http.Request) (*http.Request, error) {
+		if r.IsReplaying() {
+			req.Header.Set(headerReplayOriginalHost, req.URL.Host)
+			req.URL.Scheme = "http"
+			req.URL.Host = r.address
+			req.Host = r.address
+			return req, nil
+		}
+
+		if req.Body != nil {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, fmt.Errorf("reading request body: %+v", err)
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			req.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+
+		return req, nil
+	}
+}
+
+// responseMiddleware returns a client.ResponseMiddleware which records the request/response pair
+func (r *HttpRecorder) responseMiddleware() client.ResponseMiddleware {
+	return func(req *http.Request, resp *http.Response) (*http.Response, error) {
+		if r.IsReplaying() {
+			// restore the original URL so that subsequent requests (e.g. polling) are built from it
+			req.URL.Scheme = "https"
+			req.URL.Host = req.Header.Get(headerReplayOriginalHost)
+			req.Host = req.URL.Host
+			req.Header.Del(headerReplayOriginalHost)
+			return resp, nil
+		}
+
+		var body []byte
+		if req.GetBody != nil {
+			if rc, err := req.GetBody(); err == nil {
+				body, _ = io.ReadAll(rc)
+				rc.Close()
+			}
+		}
+
+		if err := r.record(req, body, resp); err != nil {
+			log.Printf("[WARN] Unable to record HTTP interaction for %s %s: %+v", req.Method, req.URL, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// sendDecorator returns an autorest.SendDecorator which records the request/response pair, or replays the
+// response from the cassette without sending the request
+func (r *HttpRecorder) sendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			if r.IsReplaying() {
+				return r.replay(req)
+			}
+
+			var body []byte
+			if req.Body != nil {
+				var err error
+				body, err = io.ReadAll(req.Body)
+				if err != nil {
+					return nil, fmt.Errorf("reading request body: %+v", err)
+				}
+				req.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			resp, err := s.Do(req)
+			if resp != nil {
+				if err := r.record(req, body, resp); err != nil {
+					log.Printf("[WARN] Unable to record HTTP interaction for %s %s: %+v", req.Method, req.URL, err)
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
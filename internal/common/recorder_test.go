@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestHttpRecorderRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+	recorder, err := NewHttpRecorder(RecordingModeRecord, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	upstream := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			Body:    io.NopCloser(strings.NewReader(`{"name":"example"}`)),
+			Request: req,
+		}, nil
+	})
+
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", strings.NewReader(`{"location":"westeurope"}`))
+	req.Header.Set("Authorization", "Bearer secret")
+
+	resp, err := autorest.DecorateSender(upstream, recorder.sendDecorator()).Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if body, _ := io.ReadAll(resp.Body); string(body) != `{"name":"example"}` {
+		t.Fatalf("expected the response body to be readable after recording but got %q", string(body))
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	if strings.Contains(string(contents), "secret") {
+		t.Fatalf("expected the Authorization header to be stripped from the cassette but got %s", string(contents))
+	}
+
+	replayer := &HttpRecorder{
+		mode: RecordingModeReplay,
+		path: path,
+	}
+	if err := replayer.load(); err != nil {
+		t.Fatalf("loading cassette: %+v", err)
+	}
+
+	failing := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("expected no requests to be sent when replaying")
+		return nil, nil
+	})
+
+	req, _ = http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", strings.NewReader(`{"location":"westeurope"}`))
+	resp, err = autorest.DecorateSender(failing, replayer.sendDecorator()).Do(req)
+	if err != nil {
+		t.Fatalf("replaying request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code 200 but got %d", resp.StatusCode)
+	}
+	if body, _ := io.ReadAll(resp.Body); string(body) != `{"name":"example"}` {
+		t.Fatalf("expected the recorded response body but got %q", string(body))
+	}
+
+	// each interaction should only be replayed once for non-GET requests
+	req, _ = http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", nil)
+	if _, err := autorest.DecorateSender(failing, replayer.sendDecorator()).Do(req); err == nil {
+		t.Fatalf("expected an error when the recorded interactions have been exhausted")
+	}
+}
+
+func TestHttpRecorderReRecordDiscardsPreviousInteractions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+	if err := os.WriteFile(path, []byte(`{"request":{"method":"GET","url":"https://management.azure.com/stale"},"response":{"status_code":200}}`+"\n"), 0o600); err != nil {
+		t.Fatalf("writing cassette: %+v", err)
+	}
+
+	recorder, err := NewHttpRecorder(RecordingModeRecord, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+	defer func() {
+		httpRecordersLock.Lock()
+		delete(httpRecorders, path)
+		httpRecordersLock.Unlock()
+		recorder.file.Close()
+	}()
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	if len(contents) != 0 {
+		t.Fatalf("expected the previous interactions to be discarded when re-recording but got %s", string(contents))
+	}
+}
+
+func TestNewHttpRecorderInvalidMode(t *testing.T) {
+	if _, err := NewHttpRecorder("playback", "cassette.jsonl"); err == nil {
+		t.Fatalf("expected an error for an unsupported mode")
+	}
+
+	if _, err := NewHttpRecorder(RecordingModeReplay, ""); err == nil {
+		t.Fatalf("expected an error when no cassette path was specified")
+	}
+
+	recorder, err := NewHttpRecorder("", "")
+	if err != nil || recorder != nil {
+		t.Fatalf("expected no recorder when the mode is empty")
+	}
+}
+
+func TestHttpRecorderRedactsSecrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+	recorder, err := NewHttpRecorder(RecordingModeRecord, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	upstream := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+				"Set-Cookie":   []string{"session=secret-cookie"},
+			},
+			Body:    io.NopCloser(strings.NewReader(`{"keys":[{"keyName":"key1","value":"secret-key","permissions":"FULL"}]}`)),
+			Request: req,
+		}, nil
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys?api-version=2023-01-01", strings.NewReader(`{"properties":{"administratorLoginPassword":"secret-password"}}`))
+	req.Header.Set("x-ms-encryption-key", "secret-encryption-key")
+
+	resp, err := autorest.DecorateSender(upstream, recorder.sendDecorator()).Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if body, _ := io.ReadAll(resp.Body); !strings.Contains(string(body), "secret-key") {
+		t.Fatalf("expected the response body returned to the Provider not to be redacted but got %q", string(body))
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	if strings.Contains(string(contents), "secret") {
+		t.Fatalf("expected the secrets to be redacted from the cassette but got %s", string(contents))
+	}
+	if !strings.Contains(string(contents), "key1") {
+		t.Fatalf("expected the fields which aren't secret to be recorded but got %s", string(contents))
+	}
+}
+
+func TestRedactRecordedBody(t *testing.T) {
+	testData := []struct {
+		name         string
+		input        string
+		redactValues bool
+		expected     string
+	}{
+		{
+			name:     "not json",
+			input:    "password=example",
+			expected: "password=example",
+		},
+		{
+			name:     "no secrets",
+			input:    `{"location": "westeurope", "properties": {"count": 12345678901234567890}}`,
+			expected: `{"location": "westeurope", "properties": {"count": 12345678901234567890}}`,
+		},
+		{
+			name:     "nested secret fields",
+			input:    `{"properties":{"adminPassword":"a","primaryConnectionString":"b","clientSecret":"c","sasToken":"d","keyVaultId":"e","count":1}}`,
+			expected: `{"properties":{"adminPassword":"REDACTED","clientSecret":"REDACTED","count":1,"keyVaultId":"e","primaryConnectionString":"REDACTED","sasToken":"REDACTED"}}`,
+		},
+		{
+			name:     "secret fields within a list",
+			input:    `{"value":[{"name":"a","primaryKey":"b","secondaryKey":"c"}]}`,
+			expected: `{"value":[{"name":"a","primaryKey":"REDACTED","secondaryKey":"REDACTED"}]}`,
+		},
+		{
+			name:     "value isn't redacted by default",
+			input:    `{"value":"example"}`,
+			expected: `{"value":"example"}`,
+		},
+		{
+			name:         "value is redacted for paths containing secret values",
+			input:        `{"id":"https://example.vault.azure.net/secrets/example/1","value":"example"}`,
+			redactValues: true,
+			expected:     `{"id":"https://example.vault.azure.net/secrets/example/1","value":"REDACTED"}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			if actual := redactRecordedBody([]byte(v.input), v.redactValues); actual != v.expected {
+				t.Fatalf("expected %s but got %s", v.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRecordedPathsWithSecretValues(t *testing.T) {
+	testData := map[string]bool{
+		"/subscriptions/0000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys":                           true,
+		"/subscriptions/0000/resourceGroups/example/providers/Microsoft.EventHub/namespaces/example/authorizationRules/example/listKeys":    true,
+		"/subscriptions/0000/resourceGroups/example/providers/Microsoft.Web/sites/example/config/publishingcredentials/list":                false,
+		"/subscriptions/0000/resourceGroups/example/providers/Microsoft.Batch/batchAccounts/example/regenerateKeys":                         true,
+		"/subscriptions/0000/resourceGroups/example/providers/Microsoft.ContainerService/managedClusters/example/listClusterUserCredential": true,
+		"/secrets/example/00000000000000000000000000000000":                                                                                 true,
+		"/subscriptions/0000/resourceGroups/example/providers/Microsoft.KeyVault/vaults/example/secrets/example":                            false,
+		"/subscriptions/0000/resourceGroups/example":                                                                                        false,
+	}
+
+	for path, expected := range testData {
+		if actual := recordedPathsWithSecretValues.MatchString(path); actual != expected {
+			t.Errorf("expected %q to return %t but got %t", path, expected, actual)
+		}
+	}
+}
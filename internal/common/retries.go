@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// notFoundAfterCreateWindow is how long after a Resource has been created that a 404 when retrieving it is
+// considered to be caused by eventual consistency, rather than the Resource having been removed
+const notFoundAfterCreateWindow = 5 * time.Minute
+
+// DefaultRetryableErrorCodes are the ARM error codes which are retried when no error codes are configured - these
+// are returned when a request conflicts with another operation, or a dependency hasn't finished provisioning yet
+var DefaultRetryableErrorCodes = []string{
+	"AnotherOperationInProgress",
+	"OperationPreempted",
+	"OngoingOperationInProgress",
+	"PrincipalNotFound",
+	"ReferencedResourceNotProvisioned",
+	"RetryableError",
+	"RetryableErrorDueToAnotherOperation",
+	"ServerBusy",
+}
+
+// RetryOptions configures which failed requests are retried by a RetryPolicy and how often
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the first attempt
+	MaxAttempts int
+
+	// MinimumBackoff and MaximumBackoff bound the (exponential) delay between each attempt
+	MinimumBackoff time.Duration
+	MaximumBackoff time.Duration
+
+	// ErrorCodes are the ARM error codes which should be retried
+	ErrorCodes []string
+
+	// RetryNotFoundAfterCreate is whether a 404 when retrieving a recently created Resource should be retried
+	RetryNotFoundAfterCreate bool
+}
+
+// RetryPolicy retries requests made to Resource Manager across every client configured by the Provider which fail
+// with a transient ARM error code (e.g. `AnotherOperationInProgress`), or with a 404 shortly after the Resource was
+// created - so that these are handled uniformly rather than by each Resource. This is in addition to the retries
+// for throttled requests and server errors made by the underlying clients.
+type RetryPolicy struct {
+	maxAttempts              int
+	minimumBackoff           time.Duration
+	maximumBackoff           time.Duration
+	errorCodes               map[string]struct{}
+	retryNotFoundAfterCreate bool
+
+	lock sync.Mutex
+
+	// created is the time at which each Resource (keyed by the lower-cased path) was last created
+	created map[string]time.Time
+}
+
+// NewRetryPolicy returns a RetryPolicy, or nil when requests should only be sent once - which is the case when
+// no RetryOptions are specified, since retrying is opt-in
+func NewRetryPolicy(options *RetryOptions) *RetryPolicy {
+	if options == nil || options.MaxAttempts <= 1 {
+		return nil
+	}
+
+	minimumBackoff := options.MinimumBackoff
+	if minimumBackoff <= 0 {
+		minimumBackoff = time.Second
+	}
+	maximumBackoff := options.MaximumBackoff
+	if maximumBackoff < minimumBackoff {
+		maximumBackoff = minimumBackoff
+	}
+
+	errorCodes := make(map[string]struct{})
+	for _, code := range options.ErrorCodes {
+		errorCodes[strings.ToLower(code)] = struct{}{}
+	}
+
+	return &RetryPolicy{
+		maxAttempts:              options.MaxAttempts,
+		minimumBackoff:           minimumBackoff,
+		maximumBackoff:           maximumBackoff,
+		errorCodes:               errorCodes,
+		retryNotFoundAfterCreate: options.RetryNotFoundAfterCreate,
+		created:                  map[string]time.Time{},
+	}
+}
+
+func resourcePathForRequest(req *http.Request) string {
+	return strings.TrimSuffix(strings.ToLower(req.URL.Path), "/")
+}
+
+// observe tracks when Resources are created and deleted, so that a subsequent 404 can be identified as
+// being caused by eventual consistency
+func (p *RetryPolicy) observe(req *http.Request, resp *http.Response) {
+	if !p.retryNotFoundAfterCreate || resp == nil {
+		return
+	}
+
+	path := resourcePathForRequest(req)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	switch req.Method {
+	case http.MethodPut:
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			now := time.Now()
+			p.created[path] = now
+			for k, v := range p.created {
+				if now.Sub(v) > notFoundAfterCreateWindow {
+					delete(p.created, k)
+				}
+			}
+		}
+
+	case http.MethodDelete:
+		delete(p.created, path)
+	}
+}
+
+// shouldRetry determines whether the request should be sent again, returning the reason when it should
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response) (bool, string) {
+	if resp == nil || resp.StatusCode < 400 {
+		return false, ""
+	}
+
+	if resp.StatusCode == http.StatusNotFound && p.retryNotFoundAfterCreate && req.Method == http.MethodGet {
+		p.lock.Lock()
+		createdAt, ok := p.created[resourcePathForRequest(req)]
+		p.lock.Unlock()
+		if ok && time.Since(createdAt) <= notFoundAfterCreateWindow {
+			return true, "the Resource was not found shortly after being created"
+		}
+	}
+
+	if len(p.errorCodes) == 0 {
+		return false, ""
+	}
+
+	code := armErrorCode(resp)
+	if _, ok := p.errorCodes[strings.ToLower(code)]; ok && code != "" {
+		return true, fmt.Sprintf("the error code %q is retryable", code)
+	}
+
+	return false, ""
+}
+
+// armErrorCode returns the error code from the body of a failed ARM response, preserving the body
+func armErrorCode(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var payload struct {
+		Code  string `json:"code"`
+		Error *struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return ""
+	}
+	if payload.Error != nil && payload.Error.Code != "" {
+		return payload.Error.Code
+	}
+	return payload.Code
+}
+
+// backoff returns the delay before the specified (zero-indexed) retry
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.minimumBackoff
+	for i := 0; i < retry && delay < p.maximumBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.maximumBackoff {
+		delay = p.maximumBackoff
+	}
+	return delay
+}
+
+func (p *RetryPolicy) wait(ctx context.Context, req *http.Request, retry int, reason string) error {
+	delay := p.backoff(retry)
+	log.Printf("[DEBUG] Retrying %s request to %s in %s (attempt %d of %d) since %s", req.Method, req.URL, delay, retry+2, p.maxAttempts, reason)
+
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting to retry %s request to %s: %+v", req.Method, req.URL, ctx.Err())
+	}
+}
+
+// do sends the request, retrying it using the specified send func whilst the response is retryable
+func (p *RetryPolicy) do(req *http.Request, resp *http.Response, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	var body []byte
+	if req.GetBody != nil {
+		reader, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		body, err = io.ReadAll(reader)
+		if err != nil {
+			return resp, nil
+		}
+	} else if req.Body != nil && req.Body != http.NoBody {
+		// the request can't be safely re-sent when its body can't be re-read
+		return resp, nil
+	}
+
+	for retry := 0; ; retry++ {
+		p.observe(req, resp)
+
+		ok, reason := p.shouldRetry(req, resp)
+		if !ok || retry+1 >= p.maxAttempts {
+			return resp, nil
+		}
+
+		if err := p.wait(req.Context(), req, retry, reason); err != nil {
+			return resp, err
+		}
+
+		// discard the previous response, since it's being replaced
+		if resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		next := req.Clone(req.Context())
+		if body != nil {
+			next.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		var err error
+		resp, err = send(next)
+		if err != nil {
+			return resp, err
+		}
+	}
+}
+
+// bufferRequestBody ensures that the body of the request can be re-read when it needs to be retried
+func bufferRequestBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return fmt.Errorf("reading request body: %+v", err)
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
+
+// requestMiddleware returns a client.RequestMiddleware which buffers the request body, so that it can be re-sent
+func (p *RetryPolicy) requestMiddleware() client.RequestMiddleware {
+	return func(req *http.Request) (*http.Request, error) {
+		if err := bufferRequestBody(req); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+}
+
+type retryingKey struct{}
+
+// responseMiddleware returns a client.ResponseMiddleware which re-sends the request whilst the response is retryable.
+// Each retry is sent using the specified client, so that it goes through the same pipeline as the original request
+// (authorization, request middleware and the client's own retries) - this must be the last ResponseMiddleware
+// configured, since the response middleware for the retry is run when it's sent.
+func (p *RetryPolicy) responseMiddleware(c client.BaseClient) client.ResponseMiddleware {
+	return func(req *http.Request, resp *http.Response) (*http.Response, error) {
+		// retries are made (and so are checked) by the response middleware for the original request
+		if retrying, _ := req.Context().Value(retryingKey{}).(bool); retrying {
+			return resp, nil
+		}
+
+		return p.do(req, resp, func(next *http.Request) (*http.Response, error) {
+			next = next.WithContext(context.WithValue(next.Context(), retryingKey{}, true))
+			retry := &client.Request{
+				Client:  c,
+				Request: next,
+				// the status of the response is checked by the client sending the original request
+				ValidStatusFunc: func(*http.Response, *odata.OData) bool {
+					return true
+				},
+			}
+
+			result, err := c.Execute(next.Context(), retry)
+			if result == nil {
+				return nil, err
+			}
+			return result.Response, err
+		})
+	}
+}
+
+// sendDecorator returns an autorest.SendDecorator which re-sends each request whilst the response is retryable
+func (p *RetryPolicy) sendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			if err := bufferRequestBody(req); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(req)
+			if err != nil {
+				return resp, err
+			}
+			return p.do(req, resp, s.Do)
+		})
+	}
+}
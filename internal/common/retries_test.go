@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func testRetryOptions() *RetryOptions {
+	return &RetryOptions{
+		MaxAttempts:              3,
+		MinimumBackoff:           time.Millisecond,
+		MaximumBackoff:           5 * time.Millisecond,
+		ErrorCodes:               DefaultRetryableErrorCodes,
+		RetryNotFoundAfterCreate: true,
+	}
+}
+
+func testRetryResponse(req *http.Request, statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestNewRetryPolicyDisabled(t *testing.T) {
+	if policy := NewRetryPolicy(nil); policy != nil {
+		t.Fatalf("expected no retry policy when retrying isn't configured")
+	}
+	if policy := NewRetryPolicy(&RetryOptions{MaxAttempts: 1}); policy != nil {
+		t.Fatalf("expected no retry policy when only a single attempt is allowed")
+	}
+}
+
+func TestRetryPolicyRetryableErrorCode(t *testing.T) {
+	policy := NewRetryPolicy(testRetryOptions())
+
+	var attempts int32
+	var bodies []string
+	upstream := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			return testRetryResponse(req, http.StatusConflict, `{"error":{"code":"AnotherOperationInProgress","message":"Another operation is in progress"}}`), nil
+		}
+		return testRetryResponse(req, http.StatusOK, `{}`), nil
+	})
+	sender := autorest.DecorateSender(upstream, policy.sendDecorator())
+
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", strings.NewReader(`{"location":"westeurope"}`))
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the request to succeed once retried but got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts but got %d", attempts)
+	}
+	for _, body := range bodies {
+		if body != `{"location":"westeurope"}` {
+			t.Fatalf("expected the request body to be re-sent but got %q", body)
+		}
+	}
+}
+
+func TestRetryPolicyMaxAttempts(t *testing.T) {
+	policy := NewRetryPolicy(testRetryOptions())
+
+	var attempts int32
+	upstream := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return testRetryResponse(req, http.StatusConflict, `{"error":{"code":"RetryableError"}}`), nil
+	})
+	sender := autorest.DecorateSender(upstream, policy.sendDecorator())
+
+	req, _ := http.NewRequest(http.MethodDelete, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", nil)
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected the last response to be returned but got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts but got %d", attempts)
+	}
+}
+
+func TestRetryPolicyNonRetryableErrorCode(t *testing.T) {
+	policy := NewRetryPolicy(testRetryOptions())
+
+	var attempts int32
+	upstream := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return testRetryResponse(req, http.StatusBadRequest, `{"error":{"code":"InvalidParameter"}}`), nil
+	})
+	sender := autorest.DecorateSender(upstream, policy.sendDecorator())
+
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", strings.NewReader(`{}`))
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt but got %d", attempts)
+	}
+}
+
+func TestRetryPolicyNotFoundAfterCreate(t *testing.T) {
+	policy := NewRetryPolicy(testRetryOptions())
+
+	var gets int32
+	upstream := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodPut {
+			return testRetryResponse(req, http.StatusCreated, `{}`), nil
+		}
+		if atomic.AddInt32(&gets, 1) == 1 {
+			return testRetryResponse(req, http.StatusNotFound, `{"error":{"code":"ResourceNotFound"}}`), nil
+		}
+		return testRetryResponse(req, http.StatusOK, `{}`), nil
+	})
+	sender := autorest.DecorateSender(upstream, policy.sendDecorator())
+
+	uri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01"
+
+	// a 404 prior to the Resource being created is expected (e.g. when checking whether it already exists)
+	req, _ := http.NewRequest(http.MethodGet, uri, nil)
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusNotFound || gets != 1 {
+		t.Fatalf("expected the 404 prior to creation not to be retried, got %d after %d attempt(s)", resp.StatusCode, gets)
+	}
+	atomic.StoreInt32(&gets, 0)
+
+	req, _ = http.NewRequest(http.MethodPut, uri, strings.NewReader(`{}`))
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, strings.Replace(uri, "resourceGroups", "resourcegroups", 1), nil)
+	resp, err = sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK || gets != 2 {
+		t.Fatalf("expected the 404 after creation to be retried, got %d after %d attempt(s)", resp.StatusCode, gets)
+	}
+}
+
+func TestRetryPolicyMiddleware(t *testing.T) {
+	policy := NewRetryPolicy(testRetryOptions())
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		if string(body) != `{"properties":{}}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"code":"OperationPreempted"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "Example", "2020-01-01")
+	c.DisableRetries = true
+
+	// each retry should be sent through the client, so that the request middleware is also run for it
+	var sent int32
+	c.AppendRequestMiddleware(func(req *http.Request) (*http.Request, error) {
+		atomic.AddInt32(&sent, 1)
+		return req, nil
+	})
+	ClientOptions{DisableCorrelationRequestID: true, RetryPolicy: policy}.Configure(c, nil)
+
+	req, err := c.NewRequest(context.Background(), client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodPatch,
+		Path:                "/subscriptions/00000000-0000-0000-0000-000000000000",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Body = io.NopCloser(strings.NewReader(`{"properties":{}}`))
+
+	resp, err := c.Execute(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected the request to succeed once retried but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the request to succeed once retried but got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts but got %d", attempts)
+	}
+	if sent != 2 {
+		t.Fatalf("expected the request middleware to run for each attempt but it ran %d time(s)", sent)
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const (
+	headerRateLimitRemainingSubscriptionReads   = "x-ms-ratelimit-remaining-subscription-reads"
+	headerRateLimitRemainingSubscriptionWrites  = "x-ms-ratelimit-remaining-subscription-writes"
+	headerRateLimitRemainingSubscriptionDeletes = "x-ms-ratelimit-remaining-subscription-deletes"
+
+	// these are the rates at which ARM refills the token bucket for each Subscription, per second
+	// https://learn.microsoft.com/azure/azure-resource-manager/management/request-limits-and-throttling
+	subscriptionReadsRefillRate  = 25.0
+	subscriptionWritesRefillRate = 10.0
+)
+
+var subscriptionIdRegex = regexp.MustCompile(`(?i)/subscriptions/([^/?]+)`)
+
+// RateLimiter paces the requests made to Resource Manager across every client configured by the Provider, based
+// on the number of requests remaining for each Subscription (as returned by ARM in the `x-ms-ratelimit-remaining-*`
+// headers), so that a large apply keeps within the Subscription's quota rather than each resource retrying
+// independently once it's been throttled.
+type RateLimiter struct {
+	// maxConcurrentWrites is the maximum number of write requests which can be in-flight at once, per Subscription
+	maxConcurrentWrites int
+
+	// minimumHeadroom is the number of requests which should remain in reserve for each Subscription
+	minimumHeadroom int
+
+	lock          sync.Mutex
+	subscriptions map[string]*subscriptionRateLimit
+
+	// inFlight is the request holding a write slot for each context used to send requests through go-azure-sdk
+	inFlight map[context.Context]*rateLimitedRequest
+}
+
+type subscriptionRateLimit struct {
+	reads  *rateLimitBucket
+	writes *rateLimitBucket
+
+	// writeSlots is a semaphore limiting the number of concurrent writes, nil when this is unlimited
+	writeSlots chan struct{}
+}
+
+// rateLimitBucket mirrors the token bucket ARM uses for a Subscription, refilling at the same rate
+type rateLimitBucket struct {
+	lock sync.Mutex
+
+	// tokens is the estimated number of requests which can be made before the headroom is reached - this
+	// becomes negative when requests are queued waiting for the bucket to refill
+	tokens       float64
+	refillRate   float64
+	lastUpdated  time.Time
+	blockedUntil time.Time
+
+	// observed is whether ARM has returned the number of remaining requests yet
+	observed bool
+}
+
+// NewRateLimiter returns a RateLimiter, or nil when neither a maximum number of concurrent writes nor a
+// minimum headroom has been specified
+func NewRateLimiter(maxConcurrentWrites, minimumHeadroom int) *RateLimiter {
+	if maxConcurrentWrites <= 0 && minimumHeadroom <= 0 {
+		return nil
+	}
+
+	return &RateLimiter{
+		maxConcurrentWrites: maxConcurrentWrites,
+		minimumHeadroom:     minimumHeadroom,
+		subscriptions:       map[string]*subscriptionRateLimit{},
+		inFlight:            map[context.Context]*rateLimitedRequest{},
+	}
+}
+
+func (l *RateLimiter) forRequest(req *http.Request) *subscriptionRateLimit {
+	match := subscriptionIdRegex.FindStringSubmatch(req.URL.Path)
+	if len(match) != 2 {
+		return nil
+	}
+	subscriptionId := strings.ToLower(match[1])
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if existing, ok := l.subscriptions[subscriptionId]; ok {
+		return existing
+	}
+
+	limit := &subscriptionRateLimit{
+		reads: &rateLimitBucket{
+			refillRate: subscriptionReadsRefillRate,
+		},
+		writes: &rateLimitBucket{
+			refillRate: subscriptionWritesRefillRate,
+		},
+	}
+	if l.maxConcurrentWrites > 0 {
+		limit.writeSlots = make(chan struct{}, l.maxConcurrentWrites)
+	}
+	l.subscriptions[subscriptionId] = limit
+	return limit
+}
+
+func (l *subscriptionRateLimit) bucketFor(write bool) *rateLimitBucket {
+	if write {
+		return l.writes
+	}
+	return l.reads
+}
+
+func isWriteRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// acquire waits until the request can be sent, returning a function which must be called once it's completed -
+// regardless of whether the request succeeded - so that the write slot (if any) is released
+func (l *RateLimiter) acquire(req *http.Request) (func(*http.Response), error) {
+	limit := l.forRequest(req)
+	if limit == nil {
+		return func(*http.Response) {}, nil
+	}
+
+	ctx := req.Context()
+	write := isWriteRequest(req)
+
+	bucket := limit.bucketFor(write)
+	if err := bucket.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	release := func() {}
+	if write && limit.writeSlots != nil {
+		select {
+		case limit.writeSlots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting to send %s request to %s: %+v", req.Method, req.URL, ctx.Err())
+		}
+
+		release = func() {
+			<-limit.writeSlots
+		}
+	}
+
+	return func(resp *http.Response) {
+		release()
+		if resp != nil {
+			bucket.observe(resp, write, l.minimumHeadroom)
+		}
+	}, nil
+}
+
+func (b *rateLimitBucket) wait(ctx context.Context) error {
+	b.lock.Lock()
+	now := time.Now()
+	var delay time.Duration
+	if b.observed {
+		b.tokens += now.Sub(b.lastUpdated).Seconds() * b.refillRate
+		b.lastUpdated = now
+		b.tokens--
+		if b.tokens < 0 {
+			delay = time.Duration(-b.tokens / b.refillRate * float64(time.Second))
+		}
+	}
+	if b.blockedUntil.After(now.Add(delay)) {
+		delay = b.blockedUntil.Sub(now)
+	}
+	b.lock.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Delaying request by %s to remain within the Subscription's request limits", delay)
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for the Subscription's request limits: %+v", ctx.Err())
+	}
+}
+
+func (b *rateLimitBucket) observe(resp *http.Response, write bool, minimumHeadroom int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		// all requests for this Subscription should wait until ARM allows them, rather than each retrying independently
+		retryAfter := 5 * time.Second
+		if v, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			retryAfter = time.Duration(v) * time.Second
+		}
+		b.blockedUntil = time.Now().Add(retryAfter)
+	}
+
+	header := headerRateLimitRemainingSubscriptionReads
+	if write {
+		header = headerRateLimitRemainingSubscriptionWrites
+		if resp.Request != nil && resp.Request.Method == http.MethodDelete && resp.Header.Get(headerRateLimitRemainingSubscriptionDeletes) != "" {
+			header = headerRateLimitRemainingSubscriptionDeletes
+		}
+	}
+
+	remaining, err := strconv.Atoi(resp.Header.Get(header))
+	if err != nil {
+		return
+	}
+
+	b.tokens = float64(remaining - minimumHeadroom)
+	b.lastUpdated = time.Now()
+	b.observed = true
+}
+
+type rateLimitedRequestKey struct{}
+
+// rateLimitedRequest releases the write slot (if any) acquired for a request exactly once
+type rateLimitedRequest struct {
+	once sync.Once
+	done func(*http.Response)
+
+	// ctx is the context the request was sent using, prior to being modified by the request middleware
+	ctx context.Context
+
+	// stopReleaseWhenDone stops the write slot being released once the context used for the request is done
+	stopReleaseWhenDone func() bool
+}
+
+func (r *rateLimitedRequest) release(resp *http.Response) {
+	r.once.Do(func() {
+		r.done(resp)
+	})
+}
+
+// requestMiddleware returns a client.RequestMiddleware which waits until the request can be sent
+func (l *RateLimiter) requestMiddleware() client.RequestMiddleware {
+	return func(req *http.Request) (*http.Request, error) {
+		ctx := req.Context()
+
+		// go-azure-sdk doesn't call the response middleware when the request fails to be sent (or when an earlier
+		// response middleware returns an error) - since requests using the same context are sent one after another,
+		// a write slot still held for this context belongs to a request which has completed, so is released first
+		l.releaseInFlight(ctx)
+
+		done, err := l.acquire(req)
+		if err != nil {
+			return nil, err
+		}
+
+		request := &rateLimitedRequest{
+			done: done,
+			ctx:  ctx,
+		}
+		request.stopReleaseWhenDone = context.AfterFunc(ctx, func() {
+			l.release(request, nil)
+		})
+		l.lock.Lock()
+		l.inFlight[ctx] = request
+		l.lock.Unlock()
+
+		// retryablehttp re-sends requests (for example once ARM has throttled them) without calling the request
+		// middleware again, so each further attempt at sending this request is paced when the connection is requested
+		if limit := l.forRequest(req); limit != nil {
+			bucket := limit.bucketFor(isWriteRequest(req))
+			var attempts int32
+			ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+				GetConn: func(string) {
+					if atomic.AddInt32(&attempts, 1) > 1 {
+						if err := bucket.wait(req.Context()); err != nil {
+							log.Printf("[DEBUG] %+v", err)
+						}
+					}
+				},
+			})
+		}
+
+		return req.WithContext(context.WithValue(ctx, rateLimitedRequestKey{}, request)), nil
+	}
+}
+
+// responseMiddleware returns a client.ResponseMiddleware which releases the write slot (if any) and records the
+// number of requests remaining for the Subscription
+func (l *RateLimiter) responseMiddleware() client.ResponseMiddleware {
+	return func(req *http.Request, resp *http.Response) (*http.Response, error) {
+		if request, ok := req.Context().Value(rateLimitedRequestKey{}).(*rateLimitedRequest); ok {
+			request.stopReleaseWhenDone()
+			l.release(request, resp)
+		}
+		return resp, nil
+	}
+}
+
+// release releases the write slot (if any) held by the request and stops tracking it as in-flight
+func (l *RateLimiter) release(request *rateLimitedRequest, resp *http.Response) {
+	l.lock.Lock()
+	if l.inFlight[request.ctx] == request {
+		delete(l.inFlight, request.ctx)
+	}
+	l.lock.Unlock()
+
+	request.release(resp)
+}
+
+func (l *RateLimiter) releaseInFlight(ctx context.Context) {
+	l.lock.Lock()
+	request, ok := l.inFlight[ctx]
+	l.lock.Unlock()
+
+	if ok {
+		request.stopReleaseWhenDone()
+		l.release(request, nil)
+	}
+}
+
+// sendDecorator returns an autorest.SendDecorator which paces each attempt at sending a request - since the
+// autorest based clients re-send requests through this, any attempts made once ARM has throttled the
+// Subscription are also delayed until ARM allows them
+func (l *RateLimiter) sendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			done, err := l.acquire(req)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(req)
+			done(resp)
+			return resp, err
+		})
+	}
+}
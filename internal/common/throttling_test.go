@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestNewRateLimiterDisabled(t *testing.T) {
+	if limiter := NewRateLimiter(0, 0); limiter != nil {
+		t.Fatalf("expected no rate limiter when neither option is specified")
+	}
+}
+
+func TestRateLimiterMaxConcurrentWrites(t *testing.T) {
+	limiter := NewRateLimiter(2, 0)
+
+	var inFlight, maxInFlight int32
+	upstream := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	})
+	sender := autorest.DecorateSender(upstream, limiter.sendDecorator())
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", nil)
+			if _, err := sender.Do(req); err != nil {
+				t.Errorf("sending request: %+v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 concurrent writes but got %d", maxInFlight)
+	}
+}
+
+func TestRateLimiterMinimumHeadroom(t *testing.T) {
+	limiter := NewRateLimiter(0, 10)
+
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/"+subscriptionId+"/resourceGroups/example", nil)
+	done, err := limiter.acquire(req)
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	done(&http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"X-Ms-Ratelimit-Remaining-Subscription-Reads": []string{"10"},
+		},
+		Request: req,
+	})
+
+	// the headroom has been reached, so the next request should wait for the bucket to refill
+	start := time.Now()
+	if _, err := limiter.acquire(req); err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Fatalf("expected the request to be delayed but it was sent after %s", elapsed)
+	}
+
+	// requests for other Subscriptions are unaffected
+	other, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example", nil)
+	start = time.Now()
+	if _, err := limiter.acquire(other); err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Fatalf("expected the request for another Subscription not to be delayed but it took %s", elapsed)
+	}
+}
+
+func TestRateLimiterThrottledRespectsContext(t *testing.T) {
+	limiter := NewRateLimiter(0, 1)
+
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+	done, err := limiter.acquire(req)
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	done(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"Retry-After": []string{"60"},
+		},
+		Request: req,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(req.WithContext(ctx)); err == nil {
+		t.Fatalf("expected an error when the context expires whilst throttled")
+	}
+}
+
+func TestRateLimiterReleasesWriteSlotWhenRequestFails(t *testing.T) {
+	limiter := NewRateLimiter(1, 0)
+
+	failing := autorest.DecorateSender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection reset")
+	}), limiter.sendDecorator())
+
+	// the context is never cancelled, so the slot must be released as soon as the request fails
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+		_, err := failing.Do(req)
+		cancel()
+		if err == nil || !strings.Contains(err.Error(), "connection reset") {
+			t.Fatalf("expected attempt %d to fail sending the request but got: %+v", i+1, err)
+		}
+	}
+}
+
+func TestRateLimiterThrottledDelaysRetries(t *testing.T) {
+	limiter := NewRateLimiter(1, 0)
+
+	attempts := make([]time.Time, 0)
+	sender := autorest.DecorateSender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		attempts = append(attempts, time.Now())
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}
+		if len(attempts) == 1 {
+			resp.StatusCode = http.StatusTooManyRequests
+			resp.Header.Set("Retry-After", "1")
+		}
+		return resp, nil
+	}), limiter.sendDecorator())
+
+	// each attempt made by the client's retry loop goes through the decorator, so the retry waits for ARM
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+	for i := 0; i < 2; i++ {
+		if _, err := sender.Do(req); err != nil {
+			t.Fatalf("sending attempt %d: %+v", i+1, err)
+		}
+	}
+
+	if delay := attempts[1].Sub(attempts[0]); delay < 900*time.Millisecond {
+		t.Fatalf("expected the retry to wait until the Subscription was no longer throttled but it was sent after %s", delay)
+	}
+}
+
+func TestRateLimiterMiddlewareReleasesWriteSlot(t *testing.T) {
+	limiter := NewRateLimiter(1, 0)
+
+	send := func(ctx context.Context) *http.Request {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+		req, err := limiter.requestMiddleware()(req)
+		if err != nil {
+			t.Fatalf("acquiring write slot: %+v", err)
+		}
+		return req
+	}
+
+	// the write slot is released once the response is returned
+	req := send(context.Background())
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}
+	if _, err := limiter.responseMiddleware()(req, resp); err != nil {
+		t.Fatalf("releasing write slot: %+v", err)
+	}
+
+	// where the request fails to be sent the response middleware isn't called, so the write slot is
+	// released once the context used for the request is done
+	ctx, cancel := context.WithCancel(context.Background())
+	send(ctx)
+	cancel()
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	send(ctx)
+}
+
+func TestRateLimiterMiddlewareReleasesWriteSlotForSameContext(t *testing.T) {
+	limiter := NewRateLimiter(1, 0)
+
+	// the context is never done and the response middleware isn't called (as when go-azure-sdk fails to send the
+	// request) - the next request using the same context must still be able to acquire the write slot
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+		if _, err := limiter.requestMiddleware()(req); err != nil {
+			t.Fatalf("acquiring write slot for attempt %d: %+v", i+1, err)
+		}
+	}
+}
+
+func TestRateLimiterMiddlewarePacesRetries(t *testing.T) {
+	limiter := NewRateLimiter(0, 1)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+	req, err := limiter.requestMiddleware()(req)
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+
+	// another request is throttled whilst this one is being retried by retryablehttp
+	done, err := limiter.acquire(req)
+	if err != nil {
+		t.Fatalf("acquiring: %+v", err)
+	}
+	done(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"Retry-After": []string{"1"},
+		},
+		Request: req,
+	})
+
+	trace := httptrace.ContextClientTrace(req.Context())
+	if trace == nil || trace.GetConn == nil {
+		t.Fatalf("expected the request to be traced so that each attempt is paced")
+	}
+
+	// the first attempt has already been paced by the request middleware
+	start := time.Now()
+	trace.GetConn("management.azure.com:443")
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("expected the first attempt not to be delayed but it took %s", elapsed)
+	}
+
+	trace.GetConn("management.azure.com:443")
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("expected the retry to wait until the Subscription was no longer throttled but it was sent after %s", elapsed)
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-uuid"
+)
+
+// headerExchangeId identifies a request which is re-sent by the autorest based clients for each attempt, so that
+// retries can be identified - this is never sent to Azure
+const headerExchangeId = "X-Terraform-Exchange-Id"
+
+var (
+	httpTracersLock sync.Mutex
+	httpTracers     = map[string]*HttpTracer{}
+)
+
+// HttpTracer writes a single JSON object per attempt at each HTTP exchange made by the Provider to a file,
+// containing the timing, status, correlation and throttling information for the attempt, so that slow or
+// throttled applies can be diagnosed.
+//
+// The go-azure-sdk based clients don't expose their transport, so these are traced using middleware - as such
+// any attempts made within the retry loop of these clients are traced as a single attempt.
+type HttpTracer struct {
+	path string
+
+	lock sync.Mutex
+	file *os.File
+
+	// attempts tracks the number of attempts made for each exchange, so that retries can be identified
+	attempts *exchangeAttempts
+}
+
+// exchangeAttempts tracks the number of attempts made at sending each request, keyed by the exchange ID
+type exchangeAttempts struct {
+	lock       sync.Mutex
+	attempts   map[string]*exchangeAttempt
+	lastPruned time.Time
+}
+
+type exchangeAttempt struct {
+	count    int
+	lastSeen time.Time
+}
+
+// next returns the number of attempts previously made for the specified exchange, recording this attempt
+func (a *exchangeAttempts) next(exchangeId string) int {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := time.Now()
+	if now.Sub(a.lastPruned) > time.Minute {
+		for k, v := range a.attempts {
+			if now.Sub(v.lastSeen) > exchangeAttemptsRetention {
+				delete(a.attempts, k)
+			}
+		}
+		a.lastPruned = now
+	}
+
+	attempt, ok := a.attempts[exchangeId]
+	if !ok {
+		attempt = &exchangeAttempt{}
+		a.attempts[exchangeId] = attempt
+	}
+	previous := attempt.count
+	attempt.count++
+	attempt.lastSeen = now
+	return previous
+}
+
+type httpTraceEntry struct {
+	Time                 string            `json:"time"`
+	Method               string            `json:"method"`
+	URL                  string            `json:"url"`
+	StatusCode           int               `json:"status_code,omitempty"`
+	Error                string            `json:"error,omitempty"`
+	LatencyMs            int64             `json:"latency_ms"`
+	RetryCount           int               `json:"retry_count"`
+	CorrelationRequestId string            `json:"correlation_request_id,omitempty"`
+	RequestId            string            `json:"request_id,omitempty"`
+	Throttling           map[string]string `json:"throttling,omitempty"`
+	ResourceType         string            `json:"resource_type,omitempty"`
+	ResourceId           string            `json:"resource_id,omitempty"`
+}
+
+// exchangeAttemptsRetention is how long the number of attempts made for an exchange is retained after the last attempt
+const exchangeAttemptsRetention = time.Hour
+
+type tracedResourceKey struct{}
+
+// tracedResource is the Terraform resource on whose behalf HTTP requests are being made
+type tracedResource struct {
+	resourceType string
+
+	// resourceId returns the ID of the resource - this is evaluated when each request is sent, since the ID isn't
+	// known until the resource has been created
+	resourceId func() string
+}
+
+func (r tracedResource) id() string {
+	if r.resourceId == nil {
+		return ""
+	}
+	return r.resourceId()
+}
+
+// WithTracedResource returns a copy of the context which attributes any HTTP requests made using it to the
+// specified Terraform resource - where either the resource type or ID is empty, this is inherited from the
+// resource already attributed in the context (if any).
+func WithTracedResource(ctx context.Context, resourceType string, resourceId func() string) context.Context {
+	resource := tracedResource{
+		resourceType: resourceType,
+		resourceId:   resourceId,
+	}
+
+	if existing, ok := ctx.Value(tracedResourceKey{}).(tracedResource); ok {
+		if resource.resourceType == "" {
+			resource.resourceType = existing.resourceType
+		}
+		if resource.resourceId == nil {
+			resource.resourceId = existing.resourceId
+		} else if existing.resourceId != nil {
+			id := resource.resourceId
+			resource.resourceId = func() string {
+				if v := id(); v != "" {
+					return v
+				}
+				return existing.resourceId()
+			}
+		}
+	}
+
+	return context.WithValue(ctx, tracedResourceKey{}, resource)
+}
+
+// tracedResourceTypes contains the resource type for each Terraform resource (keyed by its ResourceData) which is
+// currently being operated on, since the resource type isn't otherwise available to untyped resources
+var tracedResourceTypes sync.Map
+
+// TraceResourceType attributes any HTTP requests made during an operation on the Terraform resource represented by
+// the specified ResourceData to the resource type, returning a function which must be called once the operation completes
+func TraceResourceType(resourceData interface{}, resourceType string) func() {
+	tracedResourceTypes.Store(resourceData, resourceType)
+	return func() {
+		tracedResourceTypes.Delete(resourceData)
+	}
+}
+
+// TracedResourceType returns the resource type of the Terraform resource represented by the specified ResourceData,
+// or an empty string when this isn't known
+func TracedResourceType(resourceData interface{}) string {
+	if v, ok := tracedResourceTypes.Load(resourceData); ok {
+		return v.(string)
+	}
+	return ""
+}
+
+// NewHttpTracer returns the HttpTracer writing to the specified path, or nil when the path is empty. A single
+// HttpTracer is used for each path for the lifetime of the process, since the Provider can be configured
+// multiple times.
+func NewHttpTracer(path string) (*HttpTracer, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	httpTracersLock.Lock()
+	defer httpTracersLock.Unlock()
+
+	if existing, ok := httpTracers[path]; ok {
+		return existing, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening HTTP trace file %q: %+v", path, err)
+	}
+
+	tracer := &HttpTracer{
+		path: path,
+		file: file,
+		attempts: &exchangeAttempts{
+			attempts: map[string]*exchangeAttempt{},
+		},
+	}
+	httpTracers[path] = tracer
+	return tracer, nil
+}
+
+func (t *HttpTracer) trace(req *http.Request, exchangeId string, resp *http.Response, requestErr error, start time.Time) {
+	entry := httpTraceEntry{
+		Time:                 start.UTC().Format(time.RFC3339Nano),
+		Method:               req.Method,
+		URL:                  req.URL.String(),
+		CorrelationRequestId: req.Header.Get(HeaderCorrelationRequestID),
+	}
+
+	if !start.IsZero() {
+		entry.LatencyMs = time.Since(start).Milliseconds()
+	}
+
+	if exchangeId != "" {
+		entry.RetryCount = t.attempts.next(exchangeId)
+	}
+
+	if resource, ok := req.Context().Value(tracedResourceKey{}).(tracedResource); ok {
+		entry.ResourceType = resource.resourceType
+		entry.ResourceId = resource.id()
+	}
+
+	if requestErr != nil {
+		entry.Error = requestErr.Error()
+	}
+
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+		entry.RequestId = resp.Header.Get("x-ms-request-id")
+		if v := resp.Header.Get(HeaderCorrelationRequestID); v != "" {
+			entry.CorrelationRequestId = v
+		}
+
+		for k, v := range resp.Header {
+			name := strings.ToLower(k)
+			if (strings.HasPrefix(name, "x-ms-ratelimit-") || name == "retry-after") && len(v) > 0 {
+				if entry.Throttling == nil {
+					entry.Throttling = map[string]string{}
+				}
+				entry.Throttling[name] = v[0]
+			}
+		}
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] Unable to marshal HTTP trace for %s %s: %+v", req.Method, req.URL, err)
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, err := t.file.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Unable to write HTTP trace to %q: %+v", t.path, err)
+	}
+}
+
+type exchangeIdKey struct{}
+
+type httpTraceStartKey struct{}
+
+// requestMiddleware returns a client.RequestMiddleware which records when the request was sent - the exchange ID
+// is retained in the context, so that where the request is re-sent using the same context (e.g. by the RetryPolicy)
+// this is identified as a retry
+func (t *HttpTracer) requestMiddleware() client.RequestMiddleware {
+	return func(req *http.Request) (*http.Request, error) {
+		ctx := req.Context()
+		if exchangeId, _ := ctx.Value(exchangeIdKey{}).(string); exchangeId == "" {
+			exchangeId, err := uuid.GenerateUUID()
+			if err != nil {
+				return nil, fmt.Errorf("generating exchange ID: %+v", err)
+			}
+			ctx = context.WithValue(ctx, exchangeIdKey{}, exchangeId)
+		}
+
+		return req.WithContext(context.WithValue(ctx, httpTraceStartKey{}, time.Now())), nil
+	}
+}
+
+// responseMiddleware returns a client.ResponseMiddleware which traces the completed exchange - since the
+// go-azure-sdk based clients retry throttled requests and server errors internally, this includes the time
+// spent on any of these retries
+func (t *HttpTracer) responseMiddleware() client.ResponseMiddleware {
+	return func(req *http.Request, resp *http.Response) (*http.Response, error) {
+		exchangeId, _ := req.Context().Value(exchangeIdKey{}).(string)
+		start, _ := req.Context().Value(httpTraceStartKey{}).(time.Time)
+		t.trace(req, exchangeId, resp, nil, start)
+		return resp, nil
+	}
+}
+
+// sendDecorator returns an autorest.SendDecorator which traces each attempt at sending a request
+func (t *HttpTracer) sendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			// the autorest based clients re-send the same request for each attempt, so the exchange ID is
+			// retained on the request - but removed from the copy which is sent
+			exchangeId := req.Header.Get(headerExchangeId)
+			if exchangeId == "" {
+				exchangeId, _ = uuid.GenerateUUID()
+				req.Header.Set(headerExchangeId, exchangeId)
+			}
+			outgoing := req.Clone(req.Context())
+			outgoing.Header.Del(headerExchangeId)
+
+			start := time.Now()
+			resp, err := s.Do(outgoing)
+			t.trace(outgoing, exchangeId, resp, err, start)
+			return resp, err
+		})
+	}
+}
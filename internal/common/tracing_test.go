@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestHttpTracer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	tracer, err := NewHttpTracer(path)
+	if err != nil {
+		t.Fatalf("building tracer: %+v", err)
+	}
+
+	upstream := autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header: http.Header{
+				"X-Ms-Request-Id": []string{"11111111-1111-1111-1111-111111111111"},
+				"X-Ms-Ratelimit-Remaining-Subscription-Writes": []string{"0"},
+				"Retry-After": []string{"17"},
+			},
+			Body:    io.NopCloser(strings.NewReader("")),
+			Request: req,
+		}, nil
+	})
+	sender := autorest.DecorateSender(upstream, tracer.sendDecorator())
+
+	resourceId := func() string {
+		return "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	}
+	ctx := WithTracedResource(context.Background(), "azurerm_resource_group", resourceId)
+
+	// the autorest based clients re-send the same request for each attempt
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", nil)
+	req.Header.Set(HeaderCorrelationRequestID, "22222222-2222-2222-2222-222222222222")
+	for i := 0; i < 2; i++ {
+		if _, err := sender.Do(req); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	// whereas a subsequent request for the same URL (e.g. polling) isn't a retry
+	req, _ = http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", nil)
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening trace: %+v", err)
+	}
+	defer file.Close()
+
+	entries := make([]httpTraceEntry, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry httpTraceEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("parsing trace entry: %+v", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 trace entries but got %d", len(entries))
+	}
+
+	first := entries[0]
+	if first.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected status code 429 but got %d", first.StatusCode)
+	}
+	if first.RequestId != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the request ID to be traced but got %q", first.RequestId)
+	}
+	if first.CorrelationRequestId != "22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("expected the correlation request ID to be traced but got %q", first.CorrelationRequestId)
+	}
+	if first.ResourceType != "azurerm_resource_group" {
+		t.Fatalf("expected the resource type to be traced but got %q", first.ResourceType)
+	}
+	if first.Throttling["x-ms-ratelimit-remaining-subscription-writes"] != "0" || first.Throttling["retry-after"] != "17" {
+		t.Fatalf("expected the throttling headers to be traced but got %+v", first.Throttling)
+	}
+	if first.RetryCount != 0 || entries[1].RetryCount != 1 || entries[2].RetryCount != 0 {
+		t.Fatalf("expected retry counts of 0, 1 and 0 but got %d, %d and %d", first.RetryCount, entries[1].RetryCount, entries[2].RetryCount)
+	}
+}
+
+func TestWithTracedResourceInheritsFromParent(t *testing.T) {
+	resourceId := ""
+	ctx := WithTracedResource(context.Background(), "azurerm_resource_group", nil)
+	ctx = WithTracedResource(ctx, "", func() string {
+		return resourceId
+	})
+
+	// the resource ID is only known once the resource has been created
+	resourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+
+	resource, ok := ctx.Value(tracedResourceKey{}).(tracedResource)
+	if !ok {
+		t.Fatalf("expected a traced resource in the context")
+	}
+	if resource.resourceType != "azurerm_resource_group" {
+		t.Fatalf("expected the resource type to be inherited but got %q", resource.resourceType)
+	}
+	if resource.id() != "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example" {
+		t.Fatalf("expected the resource ID to be set but got %q", resource.id())
+	}
+}
+
+func TestHttpTracerMiddleware(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	tracer, err := NewHttpTracer(path)
+	if err != nil {
+		t.Fatalf("building tracer: %+v", err)
+	}
+
+	ctx := WithTracedResource(context.Background(), "azurerm_resource_group", nil)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01", nil)
+
+	// a request which is re-sent using the same context (e.g. by the RetryPolicy) is traced as a retry
+	for i := 0; i < 2; i++ {
+		req, err = tracer.requestMiddleware()(req)
+		if err != nil {
+			t.Fatalf("tracing request: %+v", err)
+		}
+		if v := req.Header.Get(headerExchangeId); v != "" {
+			t.Fatalf("expected no exchange ID to be sent but got %q", v)
+		}
+
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Request:    req,
+		}
+		if _, err := tracer.responseMiddleware()(req, resp); err != nil {
+			t.Fatalf("tracing response: %+v", err)
+		}
+
+		req = req.Clone(req.Context())
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening trace: %+v", err)
+	}
+	defer file.Close()
+
+	retryCounts := make([]int, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry httpTraceEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("parsing trace entry: %+v", err)
+		}
+		if entry.ResourceType != "azurerm_resource_group" {
+			t.Fatalf("expected the resource type to be traced but got %q", entry.ResourceType)
+		}
+		retryCounts = append(retryCounts, entry.RetryCount)
+	}
+
+	if len(retryCounts) != 2 || retryCounts[0] != 0 || retryCounts[1] != 1 {
+		t.Fatalf("expected retry counts of 0 and 1 but got %+v", retryCounts)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2015-04-01/activitylogs"
+)
+
+// activityLogLookback is how far back the Activity Log is queried for the latest write to a resource
+const activityLogLookback = 7 * 24 * time.Hour
+
+var subscriptionIdRegex = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)`)
+
+// resourceChange is the latest successful write to a resource, as recorded in the Activity Log
+type resourceChange struct {
+	caller    string
+	operation string
+	timestamp time.Time
+}
+
+func (c resourceChange) String() string {
+	return fmt.Sprintf("The latest change recorded in the Activity Log was %q by %q at %s.", c.operation, c.caller, c.timestamp.Format(time.RFC3339))
+}
+
+// latestWrite returns the latest successful write to the specified resource from the Activity Log, or nil when
+// there's no such write within the lookback period (or the ID isn't an ARM Resource ID)
+func latestWrite(ctx context.Context, client *activitylogs.ActivityLogsClient, resourceId string) (*resourceChange, error) {
+	match := subscriptionIdRegex.FindStringSubmatch(resourceId)
+	if len(match) != 2 {
+		return nil, nil
+	}
+	subscriptionId := commonids.NewSubscriptionID(match[1])
+
+	now := time.Now().UTC()
+	options := activitylogs.ListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("eventTimestamp ge '%s' and eventTimestamp le '%s' and resourceUri eq '%s'", now.Add(-activityLogLookback).Format(time.RFC3339), now.Format(time.RFC3339), resourceId)),
+		Select: pointer.To("authorization,caller,eventTimestamp,operationName,resourceId,status"),
+	}
+
+	resp, err := client.ListComplete(ctx, subscriptionId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing the Activity Log for %s: %+v", subscriptionId, err)
+	}
+
+	return latestWriteFromEvents(resourceId, resp.Items), nil
+}
+
+func latestWriteFromEvents(resourceId string, events []activitylogs.EventData) *resourceChange {
+	var output *resourceChange
+	for _, event := range events {
+		if !strings.EqualFold(pointer.From(event.ResourceId), resourceId) {
+			continue
+		}
+		if event.Status == nil || !strings.EqualFold(event.Status.Value, "Succeeded") {
+			continue
+		}
+
+		operation := ""
+		if event.Authorization != nil {
+			operation = pointer.From(event.Authorization.Action)
+		}
+		if !strings.HasSuffix(strings.ToLower(operation), "/write") {
+			continue
+		}
+
+		timestamp, err := event.GetEventTimestampAsTime()
+		if err != nil || timestamp == nil {
+			continue
+		}
+		if output != nil && !timestamp.After(output.timestamp) {
+			continue
+		}
+
+		output = &resourceChange{
+			caller:    pointer.From(event.Caller),
+			operation: operation,
+			timestamp: *timestamp,
+		}
+	}
+
+	return output
+}
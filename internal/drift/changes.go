@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drift
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// changedAttributePaths returns the paths to the user-configurable attributes which differ between the prior
+// and current (flattened) state - changes within a Set or Map are reported against the Set or Map itself, since
+// the keys used for the items within a Set are hashes and wouldn't be meaningful to a user.
+//
+// Attributes which are absent from the prior state aren't reported, since these haven't been stored in the state
+// yet (for example when they've been added in a newer version of the Provider) rather than having changed.
+func changedAttributePaths(resourceSchema map[string]*pluginsdk.Schema, prior, current map[string]string) []string {
+	// there's nothing to compare against when the resource has just been imported
+	if !hasAttributesOtherThanId(prior) {
+		return nil
+	}
+
+	priorAttributes := make(map[string]struct{})
+	for k := range prior {
+		priorAttributes[topLevelAttribute(k)] = struct{}{}
+	}
+
+	keys := make(map[string]struct{})
+	for k, v := range prior {
+		if existing, ok := current[k]; !ok || existing != v {
+			keys[k] = struct{}{}
+		}
+	}
+	for k := range current {
+		if _, ok := prior[k]; ok {
+			continue
+		}
+		if _, ok := priorAttributes[topLevelAttribute(k)]; ok {
+			keys[k] = struct{}{}
+		}
+	}
+
+	unique := make(map[string]struct{})
+	for k := range keys {
+		if path, ok := attributePathForKey(resourceSchema, k); ok {
+			unique[path] = struct{}{}
+		}
+	}
+
+	output := make([]string, 0, len(unique))
+	for path := range unique {
+		output = append(output, path)
+	}
+	sort.Strings(output)
+	return output
+}
+
+func topLevelAttribute(key string) string {
+	return strings.SplitN(key, ".", 2)[0]
+}
+
+func hasAttributesOtherThanId(input map[string]string) bool {
+	for k := range input {
+		if k != "id" && k != "%" {
+			return true
+		}
+	}
+	return false
+}
+
+// attributePathForKey returns the path to the user-configurable attribute containing the specified key from the
+// flattened state (e.g. `network_rules.0.ip_rules.1234` becomes `network_rules.0.ip_rules`)
+func attributePathForKey(resourceSchema map[string]*pluginsdk.Schema, key string) (string, bool) {
+	segments := strings.Split(key, ".")
+	path := make([]string, 0)
+
+	current := resourceSchema
+	for i := 0; i < len(segments); i++ {
+		name := segments[i]
+		v, ok := current[name]
+		if !ok {
+			return "", false
+		}
+
+		// Computed-only attributes are expected to change, so these aren't considered drift
+		if v.Computed && !v.Optional && !v.Required {
+			return "", false
+		}
+
+		path = append(path, name)
+		if v.Type != pluginsdk.TypeList || i+1 >= len(segments) {
+			break
+		}
+
+		// changes to the number of items or to a list of primitives are reported against the List
+		elem, ok := v.Elem.(*pluginsdk.Resource)
+		if _, err := strconv.Atoi(segments[i+1]); err != nil || !ok || i+2 >= len(segments) {
+			break
+		}
+
+		path = append(path, segments[i+1])
+		current = elem.Schema
+		i++
+	}
+
+	return strings.Join(path, "."), true
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2015-04-01/activitylogs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Reporter reports changes made outside of Terraform when a resource is refreshed - this is configured on the
+// Client when the `drift_report` block of the Provider is specified. When an Activity Logs client is specified,
+// the Activity Log is queried for the latest write to the resource, to report who made the change.
+type Reporter struct {
+	activityLogsClient *activitylogs.ActivityLogsClient
+}
+
+func NewReporter(activityLogsClient *activitylogs.ActivityLogsClient) *Reporter {
+	return &Reporter{
+		activityLogsClient: activityLogsClient,
+	}
+}
+
+// ReportDuringRead wraps the Read function of the specified Resource, so that when a Reporter is configured on the
+// Client a warning is raised listing the attributes which have changed since they were last stored in the state
+func ReportDuringRead(resourceType string, resource *pluginsdk.Resource, reporter func(meta interface{}) *Reporter) {
+	if resource == nil {
+		return
+	}
+
+	//nolint:staticcheck
+	if resource.Read != nil {
+		read := resource.Read //nolint:staticcheck
+		resource.Read = nil   //nolint:staticcheck
+		resource.ReadContext = func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(read(d, meta))
+		}
+	}
+
+	if read := resource.ReadContext; read != nil {
+		resource.ReadContext = withDriftReporting(resourceType, resource.Schema, read, reporter)
+	}
+	if read := resource.ReadWithoutTimeout; read != nil {
+		resource.ReadWithoutTimeout = withDriftReporting(resourceType, resource.Schema, read, reporter)
+	}
+}
+
+type readFunc = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics
+
+func withDriftReporting(resourceType string, resourceSchema map[string]*pluginsdk.Schema, read readFunc, reporter func(meta interface{}) *Reporter) readFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		r := reporter(meta)
+		if r == nil {
+			return read(ctx, d, meta)
+		}
+
+		prior := d.State()
+		diags := read(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		changed := changedAttributePaths(resourceSchema, stateAttributes(prior), stateAttributes(d.State()))
+		if len(changed) == 0 {
+			return diags
+		}
+
+		lines := []string{
+			"The following attributes have changed since this resource was last refreshed or applied:",
+			"",
+		}
+		for _, path := range changed {
+			lines = append(lines, fmt.Sprintf("  * %s", path))
+		}
+
+		if r.activityLogsClient != nil {
+			change, err := latestWrite(ctx, r.activityLogsClient, d.Id())
+			if err != nil {
+				log.Printf("[DEBUG] retrieving the latest write to %q from the Activity Log: %+v", d.Id(), err)
+			}
+			if change != nil {
+				lines = append(lines, "", change.String())
+			}
+		}
+
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s %q has changed outside of Terraform", resourceType, d.Id()),
+			Detail:   strings.Join(lines, "\n"),
+		})
+	}
+}
+
+func stateAttributes(input *terraform.InstanceState) map[string]string {
+	if input == nil || input.Attributes == nil {
+		return map[string]string{}
+	}
+	return input.Attributes
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drift
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2015-04-01/activitylogs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"network_rules": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"default_action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+					"ip_rules": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestChangedAttributePaths(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Prior    map[string]string
+		Current  map[string]string
+		Expected []string
+	}{
+		{
+			Name: "Imported",
+			Prior: map[string]string{
+				"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			},
+			Current: map[string]string{
+				"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+				"name": "example",
+			},
+			Expected: nil,
+		},
+		{
+			Name: "No Changes",
+			Prior: map[string]string{
+				"id":   "example",
+				"name": "example",
+			},
+			Current: map[string]string{
+				"id":   "example",
+				"name": "example",
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Computed Attribute Changed",
+			Prior: map[string]string{
+				"id":   "example",
+				"etag": "1",
+			},
+			Current: map[string]string{
+				"id":   "example",
+				"etag": "2",
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Tag Added",
+			Prior: map[string]string{
+				"id":     "example",
+				"tags.%": "0",
+			},
+			Current: map[string]string{
+				"id":          "example",
+				"tags.%":      "1",
+				"tags.source": "portal",
+			},
+			Expected: []string{"tags"},
+		},
+		{
+			Name: "Nested Changes",
+			Prior: map[string]string{
+				"id":                             "example",
+				"network_rules.#":                "1",
+				"network_rules.0.default_action": "Deny",
+				"network_rules.0.ip_rules.#":     "1",
+				"network_rules.0.ip_rules.1234":  "10.0.0.1",
+			},
+			Current: map[string]string{
+				"id":                             "example",
+				"network_rules.#":                "1",
+				"network_rules.0.default_action": "Allow",
+				"network_rules.0.ip_rules.#":     "1",
+				"network_rules.0.ip_rules.5678":  "10.0.0.2",
+			},
+			Expected: []string{"network_rules.0.default_action", "network_rules.0.ip_rules"},
+		},
+		{
+			Name: "Attribute Absent From Prior State",
+			Prior: map[string]string{
+				"id":   "example",
+				"name": "example",
+			},
+			Current: map[string]string{
+				"id":                             "example",
+				"name":                           "example",
+				"network_rules.#":                "1",
+				"network_rules.0.default_action": "Deny",
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Block Removed",
+			Prior: map[string]string{
+				"id":                             "example",
+				"network_rules.#":                "1",
+				"network_rules.0.default_action": "Deny",
+			},
+			Current: map[string]string{
+				"id":              "example",
+				"network_rules.#": "0",
+			},
+			Expected: []string{"network_rules", "network_rules.0.default_action"},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		actual := changedAttributePaths(testSchema(), testCase.Prior, testCase.Current)
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Fatalf("expected %+v but got %+v", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestLatestWriteFromEvents(t *testing.T) {
+	resourceId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	event := func(caller, action, status, timestamp string) activitylogs.EventData {
+		return activitylogs.EventData{
+			Authorization: &activitylogs.SenderAuthorization{
+				Action: pointer.To(action),
+			},
+			Caller:         pointer.To(caller),
+			EventTimestamp: pointer.To(timestamp),
+			ResourceId:     pointer.To(strings.ToUpper(resourceId)),
+			Status: &activitylogs.LocalizableString{
+				Value: status,
+			},
+		}
+	}
+
+	actual := latestWriteFromEvents(resourceId, []activitylogs.EventData{
+		event("first@example.com", "Microsoft.Resources/subscriptions/resourceGroups/write", "Succeeded", "2024-01-01T10:00:00Z"),
+		event("second@example.com", "Microsoft.Resources/subscriptions/resourceGroups/write", "Succeeded", "2024-01-02T10:00:00Z"),
+		event("failed@example.com", "Microsoft.Resources/subscriptions/resourceGroups/write", "Failed", "2024-01-03T10:00:00Z"),
+		event("reader@example.com", "Microsoft.Resources/subscriptions/resourceGroups/read", "Succeeded", "2024-01-04T10:00:00Z"),
+	})
+	if actual == nil {
+		t.Fatalf("expected a write but didn't get one")
+	}
+	if actual.caller != "second@example.com" {
+		t.Fatalf("expected the latest successful write to be by `second@example.com` but got %q", actual.caller)
+	}
+	if expected := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC); !actual.timestamp.Equal(expected) {
+		t.Fatalf("expected the latest successful write to be at %s but got %s", expected, actual.timestamp)
+	}
+}
+
+func TestReportDuringRead(t *testing.T) {
+	resource := &pluginsdk.Resource{
+		Schema: testSchema(),
+		ReadContext: func(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			return diag.FromErr(d.Set("tags", map[string]interface{}{
+				"source": "portal",
+			}))
+		},
+	}
+	ReportDuringRead("azurerm_example", resource, func(meta interface{}) *Reporter {
+		reporter, _ := meta.(*Reporter)
+		return reporter
+	})
+
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":     "example",
+			"name":   "example",
+			"tags.%": "0",
+		},
+	}
+
+	if diags := resource.ReadContext(context.Background(), resource.Data(state), nil); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics when drift reporting is disabled but got %+v", diags)
+	}
+
+	diags := resource.ReadContext(context.Background(), resource.Data(state), NewReporter(nil))
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning but got %+v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "  * tags") {
+		t.Fatalf("expected the warning to list `tags` but got %q", diags[0].Detail)
+	}
+}
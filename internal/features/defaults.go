@@ -75,5 +75,11 @@ func Default() UserFeatures {
 			VMBackupStopProtectionAndRetainDataOnDestroy: false,
 			PurgeProtectedItemsFromVaultOnDestroy:        false,
 		},
+		ResourceSku: ResourceSkuFeatures{
+			ValidateAvailabilityDuringPlan: false,
+		},
+		Quota: QuotaFeatures{
+			ValidateDuringPlan: false,
+		},
 	}
 }
@@ -20,6 +20,8 @@ type UserFeatures struct {
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
+	ResourceSku              ResourceSkuFeatures
+	Quota                    QuotaFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -104,3 +106,11 @@ type RecoveryServiceFeatures struct {
 	VMBackupStopProtectionAndRetainDataOnDestroy bool
 	PurgeProtectedItemsFromVaultOnDestroy        bool
 }
+
+type ResourceSkuFeatures struct {
+	ValidateAvailabilityDuringPlan bool
+}
+
+type QuotaFeatures struct {
+	ValidateDuringPlan bool
+}
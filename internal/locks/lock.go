@@ -3,25 +3,68 @@
 
 package locks
 
+import (
+	"context"
+	"runtime"
+	"sort"
+	"strings"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = newMutexKV()
 
+// ByID locks the specified ID, waiting indefinitely - ByIDWithContext should be used where the operation's context
+// is available, so that waiting for the lock is bounded by the resource's timeout
 func ByID(id string) {
-	armMutexKV.Lock(id)
+	_ = armMutexKV.LockWithContext(context.Background(), id, callerName(2))
+}
+
+// ByIDWithContext locks the specified ID, returning an error if the lock can't be acquired before the context
+// is cancelled or its deadline (e.g. the resource's timeout) expires
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id, callerName(2))
 }
 
-// handle the case of using the same name for different kinds of resources
+// ByName locks the specified name for this resource type (handling the same name being used for different kinds
+// of resources), waiting indefinitely - ByNameWithContext should be used where the operation's context is available
 func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	_ = armMutexKV.LockWithContext(context.Background(), nameKey(name, resourceType), callerName(2))
 }
 
+// ByNameWithContext locks the specified name for this resource type, returning an error if the lock can't be
+// acquired before the context is cancelled or its deadline (e.g. the resource's timeout) expires
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	return armMutexKV.LockWithContext(ctx, nameKey(name, resourceType), callerName(2))
+}
+
+// MultipleByName locks each of the specified names for this resource type, waiting indefinitely -
+// MultipleByNameWithContext should be used where the operation's context is available
 func MultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	_ = multipleByName(context.Background(), names, resourceType, callerName(2))
+}
 
-	for _, name := range newSlice {
-		ByName(name, resourceType)
+// MultipleByNameWithContext locks each of the specified names for this resource type, returning an error (and
+// releasing any locks already acquired) if they can't all be acquired before the context is cancelled or its
+// deadline (e.g. the resource's timeout) expires
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	return multipleByName(ctx, names, resourceType, callerName(2))
+}
+
+func multipleByName(ctx context.Context, names *[]string, resourceType string, holder string) error {
+	// the locks are always acquired in the same order, so that two callers locking an overlapping set of
+	// names can't each be left waiting on a lock the other holds
+	newSlice := sortedNames(*names)
+
+	for i, name := range newSlice {
+		if err := armMutexKV.LockWithContext(ctx, nameKey(name, resourceType), holder); err != nil {
+			for _, acquired := range newSlice[:i] {
+				UnlockByName(acquired, resourceType)
+			}
+			return err
+		}
 	}
+
+	return nil
 }
 
 func UnlockByID(id string) {
@@ -29,14 +72,42 @@ func UnlockByID(id string) {
 }
 
 func UnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armMutexKV.Unlock(nameKey(name, resourceType))
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	newSlice := sortedNames(*names)
 
 	for _, name := range newSlice {
 		UnlockByName(name, resourceType)
 	}
 }
+
+func nameKey(name string, resourceType string) string {
+	return resourceType + "." + name
+}
+
+func sortedNames(names []string) []string {
+	newSlice := removeDuplicatesFromStringArray(names)
+	sort.Strings(newSlice)
+	return newSlice
+}
+
+// callerName returns the name of the function `skip` frames up the stack, which is used to identify who holds a lock
+func callerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "an unknown caller"
+	}
+
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return "an unknown caller"
+	}
+
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i != -1 {
+		name = name[i+1:]
+	}
+	return name
+}
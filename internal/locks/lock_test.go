@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestByNameWithContextDeadline(t *testing.T) {
+	ByName("example", "azurerm_virtual_network")
+	defer UnlockByName("example", "azurerm_virtual_network")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := ByNameWithContext(ctx, "example", "azurerm_virtual_network")
+	if err == nil {
+		t.Fatalf("expected an error when the deadline expires whilst waiting for the lock")
+	}
+	if !strings.Contains(err.Error(), "TestByNameWithContextDeadline") {
+		t.Fatalf("expected the error to contain the holder of the lock but got %q", err.Error())
+	}
+}
+
+func TestMultipleByNameWithContextReleasesOnFailure(t *testing.T) {
+	ByName("b", "azurerm_subnet")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	names := []string{"c", "b", "a"}
+	if err := MultipleByNameWithContext(ctx, &names, "azurerm_subnet"); err == nil {
+		t.Fatalf("expected an error when one of the locks is held")
+	}
+	UnlockByName("b", "azurerm_subnet")
+
+	// "a" was acquired before waiting on "b", so should have been released
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := ByNameWithContext(ctx, "a", "azurerm_subnet"); err != nil {
+		t.Fatalf("expected the lock to have been released but got: %+v", err)
+	}
+	UnlockByName("a", "azurerm_subnet")
+}
+
+func TestMultipleByNameOverlappingOrder(t *testing.T) {
+	first := []string{"vnet1", "vnet2", "vnet3"}
+	second := []string{"vnet3", "vnet2", "vnet1"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		for _, names := range [][]string{first, second} {
+			names := names
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := MultipleByNameWithContext(ctx, &names, "azurerm_virtual_network"); err != nil {
+					t.Errorf("acquiring locks: %+v", err)
+					return
+				}
+				UnlockMultipleByName(&names, "azurerm_virtual_network")
+			}()
+		}
+	}
+	wg.Wait()
+}
@@ -4,48 +4,119 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"sync"
+	"time"
 )
 
+// waitWarningThreshold is how long to wait for a lock before logging which caller is holding it
+var waitWarningThreshold = 1 * time.Minute
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+}
+
+// keyMutex is a mutex which can be acquired using a context, and which tracks who is holding it
+type keyMutex struct {
+	// sem contains a value whilst the mutex is held
+	sem chan struct{}
+
+	lock     sync.Mutex
+	holder   string
+	acquired time.Time
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// a context without a deadline can never be cancelled, so this can't fail
+	_ = m.LockWithContext(context.Background(), key, callerName(2))
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the context is cancelled or
+// its deadline expires before the mutex can be acquired. Caller is responsible for calling Unlock
+// for the same key if no error is returned.
+func (m *mutexKV) LockWithContext(ctx context.Context, key string, holder string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	mutex := m.get(key)
+
+	start := time.Now()
+	ticker := time.NewTicker(waitWarningThreshold)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case mutex.sem <- struct{}{}:
+			mutex.lock.Lock()
+			mutex.holder = holder
+			mutex.acquired = time.Now()
+			mutex.lock.Unlock()
+			log.Printf("[DEBUG] Locked %q", key)
+			return nil
+
+		case <-ticker.C:
+			currentHolder, heldFor := mutex.heldBy()
+			log.Printf("[WARN] %s has been waiting %s to lock %q, which has been held by %s for %s", holder, time.Since(start).Round(time.Second), key, currentHolder, heldFor)
+
+		case <-ctx.Done():
+			currentHolder, heldFor := mutex.heldBy()
+			return fmt.Errorf("waiting to lock %q (held by %s for %s): %+v", key, currentHolder, heldFor, ctx.Err())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+	mutex := m.get(key)
+
+	mutex.lock.Lock()
+	mutex.holder = ""
+	mutex.acquired = time.Time{}
+	mutex.lock.Unlock()
+
+	select {
+	case <-mutex.sem:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked mutex %q", key))
+	}
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *keyMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &keyMutex{
+			sem: make(chan struct{}, 1),
+		}
 		m.store[key] = mutex
 	}
 	return mutex
 }
 
+// heldBy returns who is holding the mutex and for how long
+func (k *keyMutex) heldBy() (string, time.Duration) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if k.acquired.IsZero() {
+		return "an unknown caller", 0
+	}
+	return k.holder, time.Since(k.acquired).Round(time.Second)
+}
+
 // newMutexKV returns a properly initialized mutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyMutex),
 	}
 }
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/drift"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func driftReporter(meta interface{}) *drift.Reporter {
+	if client, ok := meta.(*clients.Client); ok {
+		return client.DriftReporter
+	}
+
+	return nil
+}
+
+func schemaDriftReport() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"activity_log_lookup": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the Activity Log be queried for the latest change to a resource which has changed outside of Terraform, to report who made the change and when?",
+				},
+			},
+		},
+		Description: "Raises a warning listing the attributes of a resource which have changed outside of Terraform when it's refreshed.",
+	}
+}
+
+func expandDriftReport(input []interface{}) (enabled bool, activityLogLookup bool) {
+	if len(input) == 0 {
+		return false, false
+	}
+
+	// the block can be specified without any attributes, in which case it's still enabled
+	if input[0] == nil {
+		return true, false
+	}
+
+	val := input[0].(map[string]interface{})
+	return true, val["activity_log_lookup"].(bool)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestExpandDriftReport(t *testing.T) {
+	testData := []struct {
+		Name              string
+		Input             []interface{}
+		Enabled           bool
+		ActivityLogLookup bool
+	}{
+		{
+			Name:  "Not Specified",
+			Input: []interface{}{},
+		},
+		{
+			Name:    "Empty Block",
+			Input:   []interface{}{nil},
+			Enabled: true,
+		},
+		{
+			Name: "Activity Log Lookup",
+			Input: []interface{}{
+				map[string]interface{}{
+					"activity_log_lookup": true,
+				},
+			},
+			Enabled:           true,
+			ActivityLogLookup: true,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		enabled, activityLogLookup := expandDriftReport(testCase.Input)
+		if enabled != testCase.Enabled {
+			t.Fatalf("expected enabled to be %t but got %t", testCase.Enabled, enabled)
+		}
+		if activityLogLookup != testCase.ActivityLogLookup {
+			t.Fatalf("expected activity_log_lookup to be %t but got %t", testCase.ActivityLogLookup, activityLogLookup)
+		}
+	}
+}
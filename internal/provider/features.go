@@ -372,6 +372,36 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"resource_sku": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validate_availability_during_plan": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"quota": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validate_during_plan": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -624,5 +654,25 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["resource_sku"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			resourceSkuRaw := items[0].(map[string]interface{})
+			if v, ok := resourceSkuRaw["validate_availability_during_plan"]; ok {
+				featuresMap.ResourceSku.ValidateAvailabilityDuringPlan = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["quota"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			quotaRaw := items[0].(map[string]interface{})
+			if v, ok := quotaRaw["validate_during_plan"]; ok {
+				featuresMap.Quota.ValidateDuringPlan = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
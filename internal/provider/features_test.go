@@ -88,6 +88,12 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				ResourceSku: features.ResourceSkuFeatures{
+					ValidateAvailabilityDuringPlan: false,
+				},
+				Quota: features.QuotaFeatures{
+					ValidateDuringPlan: false,
+				},
 			},
 		},
 		{
@@ -193,6 +199,16 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          true,
 						},
 					},
+					"resource_sku": []interface{}{
+						map[string]interface{}{
+							"validate_availability_during_plan": true,
+						},
+					},
+					"quota": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -263,6 +279,12 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: true,
 					PurgeProtectedItemsFromVaultOnDestroy:        true,
 				},
+				ResourceSku: features.ResourceSkuFeatures{
+					ValidateAvailabilityDuringPlan: true,
+				},
+				Quota: features.QuotaFeatures{
+					ValidateDuringPlan: true,
+				},
 			},
 		},
 		{
@@ -368,6 +390,16 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          false,
 						},
 					},
+					"resource_sku": []interface{}{
+						map[string]interface{}{
+							"validate_availability_during_plan": false,
+						},
+					},
+					"quota": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -438,6 +470,12 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				ResourceSku: features.ResourceSkuFeatures{
+					ValidateAvailabilityDuringPlan: false,
+				},
+				Quota: features.QuotaFeatures{
+					ValidateDuringPlan: false,
+				},
 			},
 		},
 	}
@@ -1678,3 +1716,133 @@ func TestExpandFeaturesRecoveryService(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesResourceSku(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_sku": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceSku: features.ResourceSkuFeatures{
+					ValidateAvailabilityDuringPlan: false,
+				},
+			},
+		},
+		{
+			Name: "Resource Sku Validate Availability During Plan Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_sku": []interface{}{
+						map[string]interface{}{
+							"validate_availability_during_plan": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceSku: features.ResourceSkuFeatures{
+					ValidateAvailabilityDuringPlan: true,
+				},
+			},
+		},
+		{
+			Name: "Resource Sku Validate Availability During Plan Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_sku": []interface{}{
+						map[string]interface{}{
+							"validate_availability_during_plan": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceSku: features.ResourceSkuFeatures{
+					ValidateAvailabilityDuringPlan: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ResourceSku, testCase.Expected.ResourceSku) {
+			t.Fatalf("Expected %+v but got %+v", result.ResourceSku, testCase.Expected.ResourceSku)
+		}
+	}
+}
+
+func TestExpandFeaturesQuota(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Quota: features.QuotaFeatures{
+					ValidateDuringPlan: false,
+				},
+			},
+		},
+		{
+			Name: "Quota Validate During Plan Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Quota: features.QuotaFeatures{
+					ValidateDuringPlan: true,
+				},
+			},
+		},
+		{
+			Name: "Quota Validate During Plan Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota": []interface{}{
+						map[string]interface{}{
+							"validate_during_plan": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Quota: features.QuotaFeatures{
+					ValidateDuringPlan: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Quota, testCase.Expected.Quota) {
+			t.Fatalf("Expected %+v but got %+v", result.Quota, testCase.Expected.Quota)
+		}
+	}
+}
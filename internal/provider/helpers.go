@@ -10,6 +10,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -143,3 +144,13 @@ func getTenantId(d *pluginsdk.ResourceData) (*string, error) {
 
 	return &tenantId, nil
 }
+
+// resourceProviderSubscriptionId returns the ID of the Subscription which resources are provisioned in by this
+// (aliased) Provider, so that the registration state of the Resource Providers in this Subscription is used
+func resourceProviderSubscriptionId(meta interface{}) string {
+	if client, ok := meta.(*clients.Client); ok && client.Account != nil {
+		return client.Account.SubscriptionId
+	}
+
+	return ""
+}
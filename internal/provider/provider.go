@@ -17,8 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/drift"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -79,6 +81,29 @@ func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	}
 }
 
+// validateResourceProviderCacheTTL checks that the `resource_provider_cache_ttl` is a positive duration (e.g. `1h`)
+func validateResourceProviderCacheTTL(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	// the Resource Providers aren't cached on disk when this isn't set
+	if v == "" {
+		return nil, nil
+	}
+
+	ttl, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a duration (e.g. `1h` or `30m`), got %q: %+v", k, v, err)}
+	}
+	if ttl <= 0 {
+		return nil, []error{fmt.Errorf("expected %q to be a positive duration, got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
 func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
@@ -138,6 +163,27 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	for name, r := range resources {
+		// the `default_tags` and `ignore_tags` are applied to every resource exposing `tags`
+		tags.ConfigureResource(name, r, tagsConfiguration)
+
+		// new resources requiring a Resource Provider which isn't registered raise an error during the plan
+		resourceproviders.ValidateRegistrationAtPlanTime(name, r, resourceProviderSubscriptionId)
+
+		// changes made outside of Terraform are reported when refreshing resources, when enabled
+		drift.ReportDuringRead(name, r, driftReporter)
+
+		// HTTP requests made during each operation are attributed to the resource, for tracing purposes
+		traceOperations(name, r)
+	}
+
+	for name, ds := range dataSources {
+		// tags which are ignored by the Provider are omitted from the `tags` of every data source
+		tags.ConfigureDataSource(ds, tagsConfiguration)
+
+		traceOperations(name, ds)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -310,6 +356,29 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "This will disable the x-ms-correlation-request-id header.",
 			},
 
+			"http_trace_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_HTTP_TRACE_FILE_PATH", ""),
+				Description: "The path to a file which a structured trace of each HTTP request made by the Provider should be appended to.",
+			},
+
+			"max_concurrent_writes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_WRITES", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of write requests which should be sent to Resource Manager concurrently for each Subscription. Defaults to `0`, which is unlimited.",
+			},
+
+			"minimum_request_headroom": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MINIMUM_REQUEST_HEADROOM", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of requests which should remain available in the Subscription's Resource Manager request limits, below which the Provider will slow down the requests it sends. Defaults to `0`.",
+			},
+
 			"disable_terraform_partner_id": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -319,7 +388,30 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
+			"retry": schemaRetry(),
+
+			"drift_report": schemaDriftReport(),
+
 			// Advanced feature flags
+			"resource_provider_cache_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_CACHE_TTL", ""),
+				ValidateFunc: validateResourceProviderCacheTTL,
+				Description:  "How long the Resource Providers available in the Subscription should be cached on disk for, for example `1h`. Defaults to not caching the Resource Providers.",
+			},
+
+			"refresh_resource_provider_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_REFRESH_RESOURCE_PROVIDER_CACHE", false),
+				Description: "Should the Resource Providers cached on disk be refreshed?",
+			},
+
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -327,6 +419,24 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"resource_provider_registrations": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceproviders.ProviderRegistrationsAll),
+				ValidateFunc: validation.StringInSlice(resourceproviders.PossibleValuesForProviderRegistrations(), false),
+				Description:  "The set of Resource Providers which should be automatically registered for the Subscription.",
+			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Description: "A list of Resource Providers to explicitly register for the Subscription, in addition to those specified for `resource_provider_registrations`.",
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -443,21 +553,70 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
 	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 
+	// `skip_provider_registration` takes precedence, since it's available via an Environment Variable
+	registrationSet := d.Get("resource_provider_registrations").(string)
+	if skipProviderRegistration {
+		registrationSet = resourceproviders.ProviderRegistrationsNone
+	}
+	requiredResourceProviders, err := resourceproviders.ForRegistrationSet(registrationSet, *utils.ExpandStringSlice(d.Get("resource_providers_to_register").([]interface{})))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	skipProviderRegistration = len(requiredResourceProviders) == 0
+
+	// registering Resource Providers on demand would bypass the selected set, so this is only done when registering them all
+	registerResourceProvidersOnDemand := !skipProviderRegistration && registrationSet == resourceproviders.ProviderRegistrationsAll
+
+	ignoredTagKeys, ignoredTagKeyPrefixes := expandIgnoreTags(d.Get("ignore_tags").([]interface{}))
+
+	reportDrift, reportDriftActivityLog := expandDriftReport(d.Get("drift_report").([]interface{}))
+
+	retryOptions, err := expandRetry(d.Get("retry").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	// the on-disk cache is configured for each (aliased) Provider, since these can use different Environments
+	var resourceProviderCache *resourceproviders.DiskCache
+	if v := d.Get("resource_provider_cache_ttl").(string); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, diag.Errorf("parsing `resource_provider_cache_ttl` %q as a duration: %+v", v, err)
+		}
+		resourceProviderCache = resourceproviders.NewDiskCache(authConfig.Environment.Name, ttl, d.Get("refresh_resource_provider_cache").(bool))
+	}
+
 	clientBuilder := clients.ClientBuilder{
-		AuthConfig:                  authConfig,
-		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
-		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
-		Features:                    expandFeatures(d.Get("features").([]interface{})),
-		MetadataHost:                d.Get("metadata_host").(string),
-		PartnerID:                   d.Get("partner_id").(string),
-		SkipProviderRegistration:    skipProviderRegistration,
-		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
-		SubscriptionID:              d.Get("subscription_id").(string),
-		TerraformVersion:            p.TerraformVersion,
+		AuthConfig:                        authConfig,
+		DefaultTags:                       expandDefaultTags(d.Get("default_tags").([]interface{})),
+		DisableCorrelationRequestID:       d.Get("disable_correlation_request_id").(bool),
+		DisableTerraformPartnerID:         d.Get("disable_terraform_partner_id").(bool),
+		Features:                          expandFeatures(d.Get("features").([]interface{})),
+		HttpTraceFilePath:                 d.Get("http_trace_file_path").(string),
+		IgnoredTagKeys:                    ignoredTagKeys,
+		IgnoredTagKeyPrefixes:             ignoredTagKeyPrefixes,
+		MaxConcurrentWrites:               d.Get("max_concurrent_writes").(int),
+		MetadataHost:                      d.Get("metadata_host").(string),
+		MinimumRequestHeadroom:            d.Get("minimum_request_headroom").(int),
+		PartnerID:                         d.Get("partner_id").(string),
+		ReportDrift:                       reportDrift,
+		ReportDriftActivityLog:            reportDriftActivityLog,
+		ResourceProviderCache:             resourceProviderCache,
+		RegisterResourceProvidersOnDemand: registerResourceProvidersOnDemand,
+		Retry:                             retryOptions,
+		SkipProviderRegistration:          skipProviderRegistration,
+		StorageUseAzureAD:                 d.Get("storage_use_azuread").(bool),
+		SubscriptionID:                    d.Get("subscription_id").(string),
+		TerraformVersion:                  p.TerraformVersion,
 
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
 		CustomCorrelationRequestID: os.Getenv("ARM_CORRELATION_REQUEST_ID"),
+
+		// these fields are intentionally not exposed in the provider block, since they're only used
+		// for running the provider against recorded HTTP interactions (e.g. in CI)
+		RecordingMode: os.Getenv("ARM_HTTP_RECORDING_MODE"),
+		RecordingPath: os.Getenv("ARM_HTTP_RECORDING_PATH"),
 	}
 
 	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
@@ -475,11 +634,10 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 
 	if !skipProviderRegistration {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
-		requiredResourceProviders := resourceproviders.Required()
 		ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
 		defer cancel()
 
-		if err := resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders); err != nil {
+		if err := resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders, resourceProviderCache); err != nil {
 			return nil, diag.Errorf(resourceProviderRegistrationErrorFmt, err)
 		}
 	}
@@ -492,8 +650,10 @@ const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers
 Terraform automatically attempts to register the Resource Providers it supports to
 ensure it's able to provision resources.
 
-If you don't have permission to register Resource Providers you may wish to use the
-"skip_provider_registration" flag in the Provider block to disable this functionality.
+If you don't have permission to register Resource Providers you may wish to set the
+"resource_provider_registrations" field in the Provider block to "none" (or "core"),
+optionally alongside "resource_providers_to_register" listing the Resource Providers
+which should be registered, to limit this functionality.
 
 Please note that if you opt out of Resource Provider Registration and Terraform tries
 to provision a resource from a Resource Provider which is unregistered, then the errors
@@ -504,7 +664,7 @@ may appear misleading - for example:
 Could indicate either that the Resource Provider "Microsoft.Foo" requires registration,
 but this could also indicate that this Azure Region doesn't support this API version.
 
-More information on the "skip_provider_registration" flag can be found here:
-https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#skip_provider_registration
+More information on the "resource_provider_registrations" field can be found here:
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#resource_provider_registrations
 
 Original Error: %s`
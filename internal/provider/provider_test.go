@@ -121,6 +121,48 @@ func TestProvider_counts(t *testing.T) {
 	log.Printf("Total:        %d", len(provider.ResourcesMap)+len(provider.DataSourcesMap))
 }
 
+func TestValidateResourceProviderCacheTTL(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// not caching the Resource Providers on disk
+			Input: "",
+			Valid: true,
+		},
+		{
+			Input: "1h",
+			Valid: true,
+		},
+		{
+			Input: "90m",
+			Valid: true,
+		},
+		{
+			Input: "1 hour",
+			Valid: false,
+		},
+		{
+			Input: "0s",
+			Valid: false,
+		},
+		{
+			Input: "-1h",
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := validateResourceProviderCacheTTL(v.Input, "resource_provider_cache_ttl")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("expected %q to be valid %t but got %t: %+v", v.Input, v.Valid, valid, errors)
+		}
+	}
+}
+
 func TestAccProvider_cliAuth(t *testing.T) {
 	if os.Getenv("TF_ACC") == "" {
 		t.Skip("TF_ACC not set")
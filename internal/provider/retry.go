@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func schemaRetry() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_attempts": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      5,
+					ValidateFunc: validation.IntBetween(1, 50),
+					Description:  "The maximum number of times a request should be sent, including the first attempt. Setting this to `1` disables retrying requests.",
+				},
+				"minimum_backoff": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "5s",
+					ValidateFunc: validateRetryBackoff,
+					Description:  "The delay before the first retry, for example `5s`, which doubles for each subsequent retry.",
+				},
+				"maximum_backoff": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "1m",
+					ValidateFunc: validateRetryBackoff,
+					Description:  "The maximum delay between retries, for example `1m`.",
+				},
+				"error_codes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of ARM error codes (for example `AnotherOperationInProgress`) which should be retried. Defaults to the error codes most commonly returned for transient failures.",
+				},
+				"not_found_after_create": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should a `404` when retrieving a Resource shortly after it was created be retried?",
+				},
+			},
+		},
+		Description: "Configures retrying requests which fail with a transient error, across all resources. Requests aren't retried by the Provider unless this block is specified.",
+	}
+}
+
+func validateRetryBackoff(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("parsing %q as a duration: %+v", k, err))
+		return
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("expected %q to be greater than zero, got %q", k, v))
+	}
+
+	return
+}
+
+func expandRetry(input []interface{}) (*common.RetryOptions, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	output := common.RetryOptions{
+		MaxAttempts:    5,
+		MinimumBackoff: 5 * time.Second,
+		MaximumBackoff: time.Minute,
+		ErrorCodes:     common.DefaultRetryableErrorCodes,
+	}
+
+	val := input[0].(map[string]interface{})
+	if v, ok := val["max_attempts"].(int); ok && v > 0 {
+		output.MaxAttempts = v
+	}
+	if v, ok := val["minimum_backoff"].(string); ok && v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `minimum_backoff` %q as a duration: %+v", v, err)
+		}
+		output.MinimumBackoff = duration
+	}
+	if v, ok := val["maximum_backoff"].(string); ok && v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `maximum_backoff` %q as a duration: %+v", v, err)
+		}
+		output.MaximumBackoff = duration
+	}
+	if output.MaximumBackoff < output.MinimumBackoff {
+		return nil, fmt.Errorf("`maximum_backoff` (%s) must be greater than or equal to `minimum_backoff` (%s)", output.MaximumBackoff, output.MinimumBackoff)
+	}
+	if v, ok := val["error_codes"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		output.ErrorCodes = *utils.ExpandStringSlice(v.List())
+	}
+	if v, ok := val["not_found_after_create"].(bool); ok {
+		output.RetryNotFoundAfterCreate = v
+	}
+
+	return &output, nil
+}
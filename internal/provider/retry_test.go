@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpandRetry(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *common.RetryOptions
+		Error    bool
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "Default Error Codes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":           3,
+					"minimum_backoff":        "2s",
+					"maximum_backoff":        "30s",
+					"error_codes":            pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+					"not_found_after_create": false,
+				},
+			},
+			Expected: &common.RetryOptions{
+				MaxAttempts:              3,
+				MinimumBackoff:           2 * time.Second,
+				MaximumBackoff:           30 * time.Second,
+				ErrorCodes:               common.DefaultRetryableErrorCodes,
+				RetryNotFoundAfterCreate: false,
+			},
+		},
+		{
+			Name: "Custom Error Codes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":           10,
+					"minimum_backoff":        "5s",
+					"maximum_backoff":        "5m",
+					"error_codes":            pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"AnotherOperationInProgress"}),
+					"not_found_after_create": true,
+				},
+			},
+			Expected: &common.RetryOptions{
+				MaxAttempts:              10,
+				MinimumBackoff:           5 * time.Second,
+				MaximumBackoff:           5 * time.Minute,
+				ErrorCodes:               []string{"AnotherOperationInProgress"},
+				RetryNotFoundAfterCreate: true,
+			},
+		},
+		{
+			Name: "Maximum Backoff less than Minimum Backoff",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":           5,
+					"minimum_backoff":        "1m",
+					"maximum_backoff":        "5s",
+					"error_codes":            pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+					"not_found_after_create": true,
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		actual, err := expandRetry(testCase.Input)
+		if testCase.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Fatalf("expected %+v but got %+v", testCase.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: map[string]string{},
+		},
+		{
+			Name: "Tags",
+			Input: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"cost-center": "1234",
+						"environment": "production",
+					},
+				},
+			},
+			Expected: map[string]string{
+				"cost-center": "1234",
+				"environment": "production",
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandDefaultTags(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
+
+func TestResourcesExposeTagsAll(t *testing.T) {
+	provider := TestAzureProvider()
+
+	testData := map[string]bool{
+		// tags can be updated in-place
+		"azurerm_resource_group":  true,
+		"azurerm_virtual_network": true,
+		// tags require replacing the resource
+		"azurerm_app_service_environment": false,
+	}
+
+	for name, expected := range testData {
+		t.Logf("[DEBUG] Testing %q..", name)
+
+		resource, ok := provider.ResourcesMap[name]
+		if !ok {
+			t.Fatalf("Resource %q isn't registered", name)
+		}
+
+		if _, actual := resource.Schema["tags_all"]; actual != expected {
+			t.Fatalf("Expected `tags_all` to be exposed: %t but got %t", expected, actual)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type operationFunc = func(d *pluginsdk.ResourceData, meta interface{}) error
+
+type operationContextFunc = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics
+
+// traceOperations attributes any HTTP requests made during each operation on the resource to the resource type, since
+// untyped resources build the context used for requests from the ResourceData (see the `timeouts` package)
+func traceOperations(resourceType string, resource *pluginsdk.Resource) {
+	traced := func(f operationFunc) operationFunc {
+		if f == nil {
+			return nil
+		}
+		return func(d *pluginsdk.ResourceData, meta interface{}) error {
+			defer common.TraceResourceType(d, resourceType)()
+			return f(d, meta)
+		}
+	}
+	tracedContext := func(f operationContextFunc) operationContextFunc {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			defer common.TraceResourceType(d, resourceType)()
+			return f(common.WithTracedResource(ctx, resourceType, d.Id), d, meta)
+		}
+	}
+
+	//nolint:staticcheck
+	resource.Create, resource.Read, resource.Update, resource.Delete = traced(resource.Create), traced(resource.Read), traced(resource.Update), traced(resource.Delete)
+	resource.CreateContext, resource.ReadContext = tracedContext(resource.CreateContext), tracedContext(resource.ReadContext)
+	resource.UpdateContext, resource.DeleteContext = tracedContext(resource.UpdateContext), tracedContext(resource.DeleteContext)
+	resource.CreateWithoutTimeout, resource.ReadWithoutTimeout = tracedContext(resource.CreateWithoutTimeout), tracedContext(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout, resource.DeleteWithoutTimeout = tracedContext(resource.UpdateWithoutTimeout), tracedContext(resource.DeleteWithoutTimeout)
+}
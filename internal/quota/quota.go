@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+// Scope identifies the set of quotas which a Change is counted against
+type Scope struct {
+	SubscriptionId string
+	Location       string
+
+	// Provider is the Resource Provider which the quotas belong to, for example `Microsoft.Compute`
+	Provider string
+}
+
+// Usage is the current consumption and limit of a single quota, as returned from a Usages API
+type Usage struct {
+	Name         string
+	DisplayName  string
+	CurrentValue int64
+	Limit        int64
+}
+
+// UsagesFunc retrieves the current Usages within a Scope
+type UsagesFunc func(ctx context.Context) ([]Usage, error)
+
+// Change is the amount of each quota which a single resource in the plan will consume (or release, when negative)
+type Change struct {
+	// ResourceId identifies the resource making the Change, so that planning the same resource more than
+	// once replaces (rather than adds to) the earlier Change for that resource
+	ResourceId string
+
+	Scope Scope
+
+	// Amounts is a map of the quota name (for example `cores` or `standardDSv5Family`) to the amount consumed
+	Amounts map[string]int64
+}
+
+// the quota consumed by each resource is accumulated for the lifetime of the provider process, so that
+// the total of all of the resources within a plan can be compared to the approved quota
+var tracker = &changeTracker{
+	changes: make(map[Scope]map[string]Change),
+	usages:  make(map[Scope][]Usage),
+}
+
+type changeTracker struct {
+	lock    sync.Mutex
+	changes map[Scope]map[string]Change
+	usages  map[Scope][]Usage
+}
+
+// Check records the quota consumed by the specified Change, and then confirms that the total consumed by all
+// of the Changes recorded within the same Scope fits within the current Usage and Limit of each quota.
+func Check(ctx context.Context, change Change, usagesFunc UsagesFunc) error {
+	return tracker.check(ctx, change, usagesFunc)
+}
+
+func (t *changeTracker) check(ctx context.Context, change Change, usagesFunc UsagesFunc) error {
+	scope := Scope{
+		SubscriptionId: strings.ToLower(change.Scope.SubscriptionId),
+		Location:       location.Normalize(change.Scope.Location),
+		Provider:       strings.ToLower(change.Scope.Provider),
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	usages, ok := t.usages[scope]
+	if !ok {
+		log.Printf("[DEBUG] Retrieving the current usage of the %s quotas in %q for Subscription %q..", change.Scope.Provider, scope.Location, change.Scope.SubscriptionId)
+		result, err := usagesFunc(ctx)
+		if err != nil {
+			return fmt.Errorf("retrieving the current usage of the %s quotas in %q: %+v", change.Scope.Provider, scope.Location, err)
+		}
+		usages = result
+		t.usages[scope] = usages
+	}
+
+	if _, ok := t.changes[scope]; !ok {
+		t.changes[scope] = make(map[string]Change)
+	}
+	t.changes[scope][strings.ToLower(change.ResourceId)] = change
+
+	planned := make(map[string]int64)
+	for _, c := range t.changes[scope] {
+		for name, amount := range c.Amounts {
+			planned[strings.ToLower(name)] += amount
+		}
+	}
+
+	consumed := make(map[string]bool)
+	for name, amount := range change.Amounts {
+		if amount > 0 {
+			consumed[strings.ToLower(name)] = true
+		}
+	}
+
+	problems := make([]string, 0)
+	for _, usage := range usages {
+		name := strings.ToLower(usage.Name)
+		// only the quotas which this resource consumes are reported, since the resource which exceeds the quota
+		// is the one which surfaces the error - and all of the resources within the plan are included in the total
+		if !consumed[name] {
+			continue
+		}
+
+		total := usage.CurrentValue + planned[name]
+		if total <= usage.Limit {
+			continue
+		}
+
+		displayName := usage.DisplayName
+		if displayName == "" {
+			displayName = usage.Name
+		}
+		problems = append(problems, fmt.Sprintf("%s (%s): %d in use + %d planned = %d, which exceeds the limit of %d", displayName, usage.Name, usage.CurrentValue, planned[name], total, usage.Limit))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+
+	return fmt.Errorf("the resources in this plan exceed the approved %s quota in %q for Subscription %q:\n\n* %s\n\nAn increase can be requested through the Quotas blade in the Azure Portal", change.Scope.Provider, scope.Location, change.Scope.SubscriptionId, strings.Join(problems, "\n* "))
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	scope := Scope{
+		SubscriptionId: "00000000-0000-0000-0000-000000000000",
+		Location:       "West Europe",
+		Provider:       "Microsoft.Compute",
+	}
+
+	usagesCalls := 0
+	usagesFunc := func(ctx context.Context) ([]Usage, error) {
+		usagesCalls++
+		return []Usage{
+			{Name: "cores", DisplayName: "Total Regional vCPUs", CurrentValue: 80, Limit: 100},
+			{Name: "standardDSv5Family", DisplayName: "Standard DSv5 Family vCPUs", CurrentValue: 8, Limit: 32},
+			{Name: "standardFSv2Family", DisplayName: "Standard FSv2 Family vCPUs", CurrentValue: 0, Limit: 100},
+		}, nil
+	}
+
+	testData := []struct {
+		Name           string
+		Change         Change
+		ExpectedErrors []string
+	}{
+		{
+			Name: "Within Quota",
+			Change: Change{
+				ResourceId: "/vm1",
+				Amounts:    map[string]int64{"cores": 8, "standardDSv5Family": 8},
+			},
+		},
+		{
+			Name: "Planning The Same Resource Again Replaces The Earlier Change",
+			Change: Change{
+				ResourceId: "/VM1",
+				Amounts:    map[string]int64{"cores": 16, "standardDSv5Family": 16},
+			},
+		},
+		{
+			Name: "Family Quota Exceeded Across Resources",
+			Change: Change{
+				ResourceId: "/vm2",
+				Amounts:    map[string]int64{"cores": 16, "standardDSv5Family": 16},
+			},
+			ExpectedErrors: []string{
+				"Standard DSv5 Family vCPUs (standardDSv5Family): 8 in use + 32 planned = 40, which exceeds the limit of 32",
+				"Total Regional vCPUs (cores): 80 in use + 32 planned = 112, which exceeds the limit of 100",
+			},
+		},
+		{
+			Name: "Releasing Quota Brings The Plan Back Within Limits",
+			Change: Change{
+				ResourceId: "/vm2",
+				Amounts:    map[string]int64{"cores": 0, "standardDSv5Family": 0},
+			},
+		},
+		{
+			Name: "Only The Quotas Consumed By The Resource Are Reported",
+			Change: Change{
+				ResourceId: "/vm3",
+				Amounts:    map[string]int64{"cores": 4, "standardFSv2Family": 4},
+			},
+		},
+		{
+			Name: "Unknown Quotas Are Ignored",
+			Change: Change{
+				ResourceId: "/vm4",
+				Amounts:    map[string]int64{"standardNewFamily": 1000},
+			},
+		},
+	}
+
+	tracker := &changeTracker{
+		changes: make(map[Scope]map[string]Change),
+		usages:  make(map[Scope][]Usage),
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		v.Change.Scope = scope
+		err := tracker.check(context.TODO(), v.Change, usagesFunc)
+		if len(v.ExpectedErrors) == 0 {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		for _, expected := range v.ExpectedErrors {
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("expected the error to contain %q but got: %+v", expected, err)
+			}
+		}
+	}
+
+	if usagesCalls != 1 {
+		t.Fatalf("expected the Usages to be retrieved once but they were retrieved %d times", usagesCalls)
+	}
+}
+
+func TestCheckUsagesError(t *testing.T) {
+	tracker := &changeTracker{
+		changes: make(map[Scope]map[string]Change),
+		usages:  make(map[Scope][]Usage),
+	}
+
+	change := Change{
+		ResourceId: "/ip1",
+		Scope: Scope{
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Location:       "westeurope",
+			Provider:       "Microsoft.Network",
+		},
+		Amounts: map[string]int64{"PublicIPAddresses": 1},
+	}
+	err := tracker.check(context.TODO(), change, func(ctx context.Context) ([]Usage, error) {
+		return nil, fmt.Errorf("boom")
+	})
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected the error from retrieving the Usages to be returned but got: %+v", err)
+	}
+}
@@ -5,72 +5,332 @@ package resourceproviders
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 )
 
-// cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
-var cachedResourceProviders *[]string
-var registeredResourceProviders *map[string]struct{}
-var unregisteredResourceProviders *map[string]struct{}
+// cachedSubscriptions contains the Resource Providers available in each Subscription (keyed by the lower-cased
+// Subscription ID), since (aliased) Providers may be configured for different Subscriptions
+var cachedSubscriptions = map[string]*cachedResourceProviders{}
+
+// cachedResourceProviders contains the Resource Providers available in a Subscription, and their registration state
+type cachedResourceProviders struct {
+	names        []string
+	registered   map[string]struct{}
+	unregistered map[string]struct{}
+}
+
+func newCachedResourceProviders(registered []string, unregistered []string) *cachedResourceProviders {
+	cached := &cachedResourceProviders{
+		names:        make([]string, 0, len(registered)+len(unregistered)),
+		registered:   make(map[string]struct{}, len(registered)),
+		unregistered: make(map[string]struct{}, len(unregistered)),
+	}
+	for _, name := range registered {
+		cached.names = append(cached.names, name)
+		cached.registered[name] = struct{}{}
+	}
+	for _, name := range unregistered {
+		cached.names = append(cached.names, name)
+		cached.unregistered[name] = struct{}{}
+	}
+	return cached
+}
+
+func cacheKey(subscriptionId commonids.SubscriptionId) string {
+	return strings.ToLower(subscriptionId.SubscriptionId)
+}
+
+// cachedForSubscription returns the cached Resource Providers for the specified Subscription, or nil when these
+// haven't been cached - the cacheLock must be held when calling this
+func cachedForSubscription(subscriptionId commonids.SubscriptionId) *cachedResourceProviders {
+	return cachedSubscriptions[cacheKey(subscriptionId)]
+}
+
+// supportedResourceProviders returns the Resource Providers available in any of the cached Subscriptions, or nil
+// when none have been cached
+func supportedResourceProviders() []string {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if len(cachedSubscriptions) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+	output := make([]string, 0)
+	for _, cached := range cachedSubscriptions {
+		for _, name := range cached.names {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			output = append(output, name)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
 
 var cacheLock = &sync.Mutex{}
 
-// CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
-// and caches them, for used in enhanced validation
-func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
-	// already populated
-	if cachedResourceProviders != nil {
+// diskCacheDirectory returns the directory the Resource Providers are cached in - this is only a variable to aid testing
+var diskCacheDirectory = func() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "terraform-provider-azurerm"), nil
+}
+
+const diskCacheFilePrefix = "resource-providers-"
+
+// DiskCache caches the Resource Providers available in each Subscription on disk, so that subsequent runs of the
+// Provider don't need to list them - this is configured for each (aliased) Provider, which may use a different
+// Environment or TTL. A nil DiskCache disables the on-disk cache.
+type DiskCache struct {
+	environment string
+	ttl         time.Duration
+	refresh     bool
+}
+
+// NewDiskCache returns a DiskCache for the specified Environment, which caches the Resource Providers for the specified
+// duration. When `refresh` is true any existing cache is ignored and replaced. A TTL of zero disables the on-disk cache,
+// in which case this returns nil.
+func NewDiskCache(environment string, ttl time.Duration, refresh bool) *DiskCache {
+	if ttl <= 0 {
 		return nil
 	}
 
-	if err := populateCache(ctx, client, subscriptionId); err != nil {
+	return &DiskCache{
+		environment: environment,
+		ttl:         ttl,
+		refresh:     refresh,
+	}
+}
+
+type diskCacheContents struct {
+	Environment    string    `json:"environment"`
+	SubscriptionId string    `json:"subscription_id"`
+	RetrievedAt    time.Time `json:"retrieved_at"`
+	Registered     []string  `json:"registered"`
+	Unregistered   []string  `json:"unregistered"`
+}
+
+// CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
+// and caches them, for used in enhanced validation
+func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, diskCache *DiskCache) error {
+	if err := populateCache(ctx, client, subscriptionId, diskCache); err != nil {
 		return fmt.Errorf("populating cache: %+v", err)
 	}
 
 	return nil
 }
 
+// ClearCache clears the Resource Providers cached in memory
 func ClearCache() {
 	cacheLock.Lock()
-	cachedResourceProviders = nil
-	registeredResourceProviders = nil
-	unregisteredResourceProviders = nil
+	cachedSubscriptions = map[string]*cachedResourceProviders{}
 	cacheLock.Unlock()
 }
 
-func populateCache(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
+// Clear removes the Resource Providers cached on disk
+func (c *DiskCache) Clear() {
+	if c == nil {
+		return
+	}
+
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
 
+	dir, err := diskCacheDirectory()
+	if err != nil {
+		return
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, diskCacheFilePrefix+"*.json"))
+	for _, file := range files {
+		if err := os.Remove(file); err != nil {
+			log.Printf("[DEBUG] Unable to remove the cached Resource Providers %q: %+v", file, err)
+		}
+	}
+}
+
+// populateCache retrieves the Resource Providers available in the specified Subscription, unless these are already cached
+func populateCache(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, diskCache *DiskCache) error {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	// already populated
+	if cachedForSubscription(subscriptionId) != nil {
+		return nil
+	}
+
+	if diskCache != nil && !diskCache.refresh {
+		if diskCache.read(subscriptionId) {
+			return nil
+		}
+	}
+
 	providers, err := client.ListComplete(ctx, subscriptionId, providers.DefaultListOperationOptions())
 	if err != nil {
 		return fmt.Errorf("listing Resource Providers: %+v", err)
 	}
 
-	providerNames := make([]string, 0)
-	registeredProviders := make(map[string]struct{}, 0)
-	unregisteredProviders := make(map[string]struct{}, 0)
+	registered := make([]string, 0)
+	unregistered := make([]string, 0)
 	for _, provider := range providers.Items {
 		if provider.Namespace == nil {
 			continue
 		}
 
-		providerNames = append(providerNames, *provider.Namespace)
-		registered := provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered")
-		if registered {
-			registeredProviders[*provider.Namespace] = struct{}{}
+		if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered") {
+			registered = append(registered, *provider.Namespace)
 		} else {
-			unregisteredProviders[*provider.Namespace] = struct{}{}
+			unregistered = append(unregistered, *provider.Namespace)
 		}
 	}
 
-	cachedResourceProviders = &providerNames
-	registeredResourceProviders = &registeredProviders
-	unregisteredResourceProviders = &unregisteredProviders
+	cachedSubscriptions[cacheKey(subscriptionId)] = newCachedResourceProviders(registered, unregistered)
+
+	diskCache.write(subscriptionId)
 	return nil
 }
+
+// markAsRegistered updates the cache once the specified Resource Providers have been registered in the Subscription
+func markAsRegistered(subscriptionId commonids.SubscriptionId, providerNames []string, diskCache *DiskCache) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	cached := cachedForSubscription(subscriptionId)
+	if cached == nil {
+		return
+	}
+
+	for _, providerName := range providerNames {
+		cached.registered[providerName] = struct{}{}
+		delete(cached.unregistered, providerName)
+	}
+
+	diskCache.write(subscriptionId)
+}
+
+func (c *DiskCache) path(subscriptionId commonids.SubscriptionId) (string, error) {
+	dir, err := diskCacheDirectory()
+	if err != nil {
+		return "", err
+	}
+
+	key := sha256.Sum256([]byte(strings.ToLower(fmt.Sprintf("%s/%s", c.environment, subscriptionId.SubscriptionId))))
+	return filepath.Join(dir, fmt.Sprintf("%s%x.json", diskCacheFilePrefix, key[:8])), nil
+}
+
+// read populates the cache from disk, returning whether an unexpired cache was found for this Subscription
+// and Environment - the cacheLock must be held when calling this
+func (c *DiskCache) read(subscriptionId commonids.SubscriptionId) bool {
+	path, err := c.path(subscriptionId)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine the path for the cached Resource Providers: %+v", err)
+		return false
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[DEBUG] Unable to read the cached Resource Providers from %q: %+v", path, err)
+		}
+		return false
+	}
+
+	var cached diskCacheContents
+	if err := json.Unmarshal(contents, &cached); err != nil {
+		log.Printf("[DEBUG] Unable to parse the cached Resource Providers from %q: %+v", path, err)
+		return false
+	}
+
+	if !strings.EqualFold(cached.Environment, c.environment) || !strings.EqualFold(cached.SubscriptionId, subscriptionId.SubscriptionId) {
+		return false
+	}
+	if time.Since(cached.RetrievedAt) > c.ttl {
+		log.Printf("[DEBUG] The cached Resource Providers in %q have expired", path)
+		return false
+	}
+
+	log.Printf("[DEBUG] Using the Resource Providers cached in %q at %s", path, cached.RetrievedAt.Format(time.RFC3339))
+	cachedSubscriptions[cacheKey(subscriptionId)] = newCachedResourceProviders(cached.Registered, cached.Unregistered)
+	return true
+}
+
+// write writes the cached Resource Providers for the specified Subscription to disk when configured - the
+// cacheLock must be held when calling this
+func (c *DiskCache) write(subscriptionId commonids.SubscriptionId) {
+	if c == nil {
+		return
+	}
+
+	existing := cachedForSubscription(subscriptionId)
+	if existing == nil {
+		return
+	}
+
+	path, err := c.path(subscriptionId)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine the path for the cached Resource Providers: %+v", err)
+		return
+	}
+
+	cached := diskCacheContents{
+		Environment:    c.environment,
+		SubscriptionId: subscriptionId.SubscriptionId,
+		RetrievedAt:    time.Now().UTC(),
+		Registered:     make([]string, 0),
+		Unregistered:   make([]string, 0),
+	}
+	for name := range existing.registered {
+		cached.Registered = append(cached.Registered, name)
+	}
+	for name := range existing.unregistered {
+		cached.Unregistered = append(cached.Unregistered, name)
+	}
+	sort.Strings(cached.Registered)
+	sort.Strings(cached.Unregistered)
+
+	contents, err := json.Marshal(cached)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to marshal the cached Resource Providers: %+v", err)
+		return
+	}
+
+	// other instances of the Provider may be reading this concurrently, so the file is replaced rather than rewritten
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Printf("[DEBUG] Unable to create the directory for the cached Resource Providers: %+v", err)
+		return
+	}
+	temp, err := os.CreateTemp(filepath.Dir(path), diskCacheFilePrefix+"*.tmp")
+	if err != nil {
+		log.Printf("[DEBUG] Unable to write the cached Resource Providers: %+v", err)
+		return
+	}
+	_, writeErr := temp.Write(contents)
+	closeErr := temp.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(temp.Name())
+		log.Printf("[DEBUG] Unable to write the cached Resource Providers to %q: %+v %+v", temp.Name(), writeErr, closeErr)
+		return
+	}
+	if err := os.Rename(temp.Name(), path); err != nil {
+		_ = os.Remove(temp.Name())
+		log.Printf("[DEBUG] Unable to write the cached Resource Providers to %q: %+v", path, err)
+	}
+}
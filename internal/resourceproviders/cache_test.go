@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	diskCacheDirectory = func() (string, error) {
+		return dir, nil
+	}
+	defer ClearCache()
+
+	subscriptionId := commonids.NewSubscriptionID("00000000-0000-0000-0000-000000000000")
+	diskCache := NewDiskCache("public", time.Hour, false)
+
+	// another Subscription is cached alongside, which mustn't be written to the cache for this Subscription
+	otherSubscriptionId := commonids.NewSubscriptionID("11111111-1111-1111-1111-111111111111")
+
+	cacheLock.Lock()
+	cachedSubscriptions[cacheKey(subscriptionId)] = newCachedResourceProviders([]string{"Microsoft.Compute"}, []string{"Microsoft.Network"})
+	cachedSubscriptions[cacheKey(otherSubscriptionId)] = newCachedResourceProviders([]string{"Microsoft.Storage"}, []string{"Microsoft.Web"})
+	diskCache.write(subscriptionId)
+	cacheLock.Unlock()
+
+	markAsRegistered(subscriptionId, []string{"Microsoft.Network"}, diskCache)
+
+	cacheLock.Lock()
+	cachedSubscriptions = map[string]*cachedResourceProviders{}
+	found := diskCache.read(subscriptionId)
+	cached := cachedForSubscription(subscriptionId)
+	cacheLock.Unlock()
+
+	if !found || cached == nil {
+		t.Fatalf("expected the Resource Providers to be read from the disk cache")
+	}
+	if _, ok := cached.registered["Microsoft.Network"]; !ok {
+		t.Fatalf("expected Microsoft.Network to be cached as registered")
+	}
+	if len(cached.names) != 2 {
+		t.Fatalf("expected 2 cached Resource Providers but got %d", len(cached.names))
+	}
+	if _, ok := cached.registered["Microsoft.Storage"]; ok {
+		t.Fatalf("expected the Resource Providers for another Subscription not to be written to the disk cache")
+	}
+
+	// a different Environment (e.g. configured for an aliased Provider) shouldn't use the same cache
+	cacheLock.Lock()
+	found = NewDiskCache("usgovernment", time.Hour, false).read(subscriptionId)
+	cacheLock.Unlock()
+	if found {
+		t.Fatalf("expected the disk cache for another Environment not to be used")
+	}
+
+	// nor should an expired cache
+	time.Sleep(time.Millisecond)
+	cacheLock.Lock()
+	found = NewDiskCache("public", time.Nanosecond, false).read(subscriptionId)
+	cacheLock.Unlock()
+	if found {
+		t.Fatalf("expected an expired disk cache not to be used")
+	}
+
+	// whilst the cache for the original Environment is still used
+	cacheLock.Lock()
+	found = diskCache.read(subscriptionId)
+	cacheLock.Unlock()
+	if !found {
+		t.Fatalf("expected the disk cache to be used")
+	}
+
+	if NewDiskCache("public", 0, false) != nil {
+		t.Fatalf("expected a TTL of zero to disable the disk cache")
+	}
+
+	diskCache.Clear()
+	files, _ := filepath.Glob(filepath.Join(dir, "*"))
+	if len(files) != 0 {
+		t.Fatalf("expected the disk cache to be removed but found %+v", files)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Fatalf("expected the cache directory to remain: %+v", err)
+	}
+}
@@ -17,15 +17,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders/custompollers"
 )
 
-func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, requiredRPs map[string]struct{}) error {
-	if cachedResourceProviders == nil || registeredResourceProviders == nil || unregisteredResourceProviders == nil {
-		if err := populateCache(ctx, client, subscriptionId); err != nil {
-			return fmt.Errorf("populating Resource Provider cache: %+v", err)
-		}
+func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, requiredRPs map[string]struct{}, diskCache *DiskCache) error {
+	if err := populateCache(ctx, client, subscriptionId, diskCache); err != nil {
+		return fmt.Errorf("populating Resource Provider cache: %+v", err)
 	}
 
 	log.Printf("[DEBUG] Determining which Resource Providers require Registration")
-	providersToRegister, err := DetermineWhichRequiredResourceProvidersRequireRegistration(requiredRPs)
+	providersToRegister, err := DetermineWhichRequiredResourceProvidersRequireRegistration(subscriptionId, requiredRPs)
 	if err != nil {
 		return fmt.Errorf("determining which Required Resource Providers require registration: %+v", err)
 	}
@@ -35,6 +33,7 @@ func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, su
 		if err := registerForSubscription(ctx, client, subscriptionId, *providersToRegister); err != nil {
 			return err
 		}
+		markAsRegistered(subscriptionId, *providersToRegister, diskCache)
 	} else {
 		log.Printf("[DEBUG] All required Resource Providers are registered")
 	}
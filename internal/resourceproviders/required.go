@@ -3,6 +3,110 @@
 
 package resourceproviders
 
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ProviderRegistrationsNone registers no Resource Providers
+	ProviderRegistrationsNone = "none"
+
+	// ProviderRegistrationsCore registers the Resource Providers required by the most commonly used resources
+	ProviderRegistrationsCore = "core"
+
+	// ProviderRegistrationsExtended registers the Core Resource Providers, plus those for other widely used services
+	ProviderRegistrationsExtended = "extended"
+
+	// ProviderRegistrationsAll registers all of the Resource Providers supported by the Provider
+	ProviderRegistrationsAll = "all"
+)
+
+// PossibleValuesForProviderRegistrations returns the sets of Resource Providers which can be registered
+func PossibleValuesForProviderRegistrations() []string {
+	return []string{
+		ProviderRegistrationsNone,
+		ProviderRegistrationsCore,
+		ProviderRegistrationsExtended,
+		ProviderRegistrationsAll,
+	}
+}
+
+// ForRegistrationSet returns the Resource Providers which should be registered for the specified set, together with
+// any additional Resource Providers which should be registered explicitly
+func ForRegistrationSet(set string, additional []string) (map[string]struct{}, error) {
+	var output map[string]struct{}
+	switch strings.ToLower(set) {
+	case ProviderRegistrationsNone:
+		output = map[string]struct{}{}
+	case ProviderRegistrationsCore:
+		output = Core()
+	case ProviderRegistrationsExtended:
+		output = Extended()
+	case ProviderRegistrationsAll:
+		output = Required()
+	default:
+		return nil, fmt.Errorf("unsupported set of Resource Providers %q - expected one of %q", set, strings.Join(PossibleValuesForProviderRegistrations(), ", "))
+	}
+
+	for _, v := range additional {
+		output[v] = struct{}{}
+	}
+
+	return output, nil
+}
+
+// Core returns the Resource Providers required by the most commonly used resources, such as
+// Resource Groups, Networking, Compute, Storage and Key Vault
+func Core() map[string]struct{} {
+	// NOTE: Resource Providers in this list are case sensitive
+	return map[string]struct{}{
+		"Microsoft.Authorization":       {},
+		"Microsoft.Compute":             {},
+		"Microsoft.KeyVault":            {},
+		"Microsoft.ManagedIdentity":     {},
+		"Microsoft.MarketplaceOrdering": {},
+		"Microsoft.Network":             {},
+		"Microsoft.Resources":           {},
+		"Microsoft.Storage":             {},
+		"microsoft.insights":            {},
+	}
+}
+
+// Extended returns the Core Resource Providers, plus those for other widely used services
+func Extended() map[string]struct{} {
+	output := Core()
+	for _, v := range []string{
+		"Microsoft.ApiManagement",
+		"Microsoft.AppConfiguration",
+		"Microsoft.Cache",
+		"Microsoft.Cdn",
+		"Microsoft.CognitiveServices",
+		"Microsoft.ContainerInstance",
+		"Microsoft.ContainerRegistry",
+		"Microsoft.ContainerService",
+		"Microsoft.DBforMySQL",
+		"Microsoft.DBforPostgreSQL",
+		"Microsoft.DocumentDB",
+		"Microsoft.EventGrid",
+		"Microsoft.EventHub",
+		"Microsoft.Logic",
+		"Microsoft.ManagedServices",
+		"Microsoft.Management",
+		"Microsoft.OperationalInsights",
+		"Microsoft.OperationsManagement",
+		"Microsoft.PolicyInsights",
+		"Microsoft.RecoveryServices",
+		"Microsoft.Security",
+		"Microsoft.ServiceBus",
+		"Microsoft.Sql",
+		"Microsoft.Web",
+	} {
+		output[v] = struct{}{}
+	}
+	return output
+}
+
 // Required returns core Resource Providers used by the AzureRM Provider
 // Terraform auto-registers core Resource Providers, since those RP’s should be enabled by default
 // but that list is something we come up with based on experience.
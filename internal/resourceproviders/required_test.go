@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestForRegistrationSet(t *testing.T) {
+	testCases := []struct {
+		set        string
+		additional []string
+		expected   []string
+		excluded   []string
+		error      bool
+	}{
+		{
+			set:      ProviderRegistrationsNone,
+			expected: []string{},
+			excluded: []string{"Microsoft.Compute"},
+		},
+		{
+			set:        ProviderRegistrationsNone,
+			additional: []string{"Microsoft.ContainerService"},
+			expected:   []string{"Microsoft.ContainerService"},
+			excluded:   []string{"Microsoft.Compute"},
+		},
+		{
+			set:      ProviderRegistrationsCore,
+			expected: []string{"Microsoft.Compute", "Microsoft.Network", "Microsoft.Storage"},
+			excluded: []string{"Microsoft.ContainerService"},
+		},
+		{
+			set:      ProviderRegistrationsExtended,
+			expected: []string{"Microsoft.Compute", "Microsoft.ContainerService"},
+			excluded: []string{"Microsoft.AVS"},
+		},
+		{
+			set:      ProviderRegistrationsAll,
+			expected: []string{"Microsoft.Compute", "Microsoft.ContainerService", "Microsoft.AVS"},
+		},
+		{
+			set:   "legacy",
+			error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q / %s", tc.set, strings.Join(tc.additional, ", "))
+
+		actual, err := ForRegistrationSet(tc.set, tc.additional)
+		if err != nil {
+			if !tc.error {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			continue
+		}
+		if tc.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		for _, v := range tc.expected {
+			if _, ok := actual[v]; !ok {
+				t.Fatalf("expected %q to be registered", v)
+			}
+		}
+		for _, v := range tc.excluded {
+			if _, ok := actual[v]; ok {
+				t.Fatalf("expected %q not to be registered", v)
+			}
+		}
+	}
+}
+
+func TestExtendedIsSubsetOfAll(t *testing.T) {
+	all := Required()
+	for v := range Extended() {
+		if _, ok := all[v]; !ok {
+			t.Fatalf("%q is registered for %q but not %q", v, ProviderRegistrationsExtended, ProviderRegistrationsAll)
+		}
+	}
+}
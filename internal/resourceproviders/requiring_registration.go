@@ -4,23 +4,33 @@
 package resourceproviders
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // DetermineWhichRequiredResourceProvidersRequireRegistration determines which Resource Providers require registration to be able to be used
-func DetermineWhichRequiredResourceProvidersRequireRegistration(requiredResourceProviders map[string]struct{}) (*[]string, error) {
-	if registeredResourceProviders == nil || unregisteredResourceProviders == nil {
-		return nil, fmt.Errorf("internal-error: the registered/unregistered Resource Provider cache isn't populated")
+// in the specified Subscription
+func DetermineWhichRequiredResourceProvidersRequireRegistration(subscriptionId commonids.SubscriptionId, requiredResourceProviders map[string]struct{}) (*[]string, error) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	cached := cachedForSubscription(subscriptionId)
+	if cached == nil {
+		return nil, fmt.Errorf("internal-error: the registered/unregistered Resource Provider cache isn't populated for %s", subscriptionId)
 	}
 
 	requiringRegistration := make([]string, 0)
 	for providerName := range requiredResourceProviders {
-		if _, isRegistered := (*registeredResourceProviders)[providerName]; isRegistered {
+		if _, isRegistered := cached.registered[providerName]; isRegistered {
 			continue
 		}
 
-		if _, isUnregistered := (*unregisteredResourceProviders)[providerName]; !isUnregistered {
+		if _, isUnregistered := cached.unregistered[providerName]; !isUnregistered {
 			// some RPs may not exist in some non-public clouds, so we'll log a warning here instead of raising an error
 			log.Printf("[WARN] The required Resource Provider %q wasn't returned from the Azure API", providerName)
 			continue
@@ -31,3 +41,77 @@ func DetermineWhichRequiredResourceProvidersRequireRegistration(requiredResource
 
 	return &requiringRegistration, nil
 }
+
+// ResourceProviderForResourceType returns the Resource Provider required by the specified Terraform Resource Type - this
+// is determined from the Resource ID parser for each Resource when running 'make generate', and is therefore unknown for
+// Resources which aren't scoped to a Resource Provider (such as Resource Groups).
+func ResourceProviderForResourceType(resourceType string) (string, bool) {
+	v, ok := resourceProvidersForResourceTypes[resourceType]
+	return v, ok
+}
+
+// ValidateRegisteredForResourceType returns an error when the Resource Provider required by the specified Terraform
+// Resource Type is known to be unregistered in the specified Subscription - this is best-effort, and returns no error
+// when the Resource Provider (or its registration state in the Subscription) is unknown.
+func ValidateRegisteredForResourceType(subscriptionId commonids.SubscriptionId, resourceType string) error {
+	providerName, ok := ResourceProviderForResourceType(resourceType)
+	if !ok {
+		return nil
+	}
+
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	cached := cachedForSubscription(subscriptionId)
+	if cached == nil {
+		return nil
+	}
+
+	for name := range cached.unregistered {
+		if strings.EqualFold(name, providerName) {
+			return fmt.Errorf(resourceProviderUnregisteredErrorFmt, resourceType, name, name, name)
+		}
+	}
+
+	return nil
+}
+
+const resourceProviderUnregisteredErrorFmt = `the %q resource requires the Resource Provider %q, which isn't registered in this Subscription.
+
+This Resource Provider can be registered by either adding %q to the "resource_providers_to_register"
+list in the Provider block, or running "az provider register --namespace %s" using an account
+with permission to register Resource Providers.`
+
+// ValidateRegistrationAtPlanTime adds a CustomizeDiff to the specified Resource which returns an error during the
+// plan when a new instance of the Resource requires a Resource Provider which isn't registered in the Subscription
+// the Resource is being provisioned in - as returned by the specified function, from the Provider's meta
+func ValidateRegistrationAtPlanTime(resourceType string, resource *pluginsdk.Resource, subscriptionId func(meta interface{}) string) {
+	if resource == nil {
+		return
+	}
+
+	if _, ok := ResourceProviderForResourceType(resourceType); !ok {
+		return
+	}
+
+	validateFunc := func(_ context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		// existing resources must have been provisioned, so there's no need to check these
+		if d.Id() != "" {
+			return nil
+		}
+
+		id := subscriptionId(meta)
+		if id == "" {
+			return nil
+		}
+
+		return ValidateRegisteredForResourceType(commonids.NewSubscriptionID(id), resourceType)
+	}
+
+	if resource.CustomizeDiff == nil {
+		resource.CustomizeDiff = validateFunc
+		return
+	}
+
+	resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(validateFunc, resource.CustomizeDiff)
+}
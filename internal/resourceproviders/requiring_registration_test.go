@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+func TestResourceProviderForResourceType(t *testing.T) {
+	testCases := []struct {
+		resourceType string
+		expected     string
+	}{
+		{
+			resourceType: "azurerm_kubernetes_cluster",
+			expected:     "Microsoft.ContainerService",
+		},
+		{
+			resourceType: "azurerm_linux_virtual_machine_scale_set",
+			expected:     "Microsoft.Compute",
+		},
+		{
+			resourceType: "azurerm_private_dns_zone",
+			expected:     "Microsoft.Network",
+		},
+		{
+			resourceType: "azurerm_storage_account",
+			expected:     "Microsoft.Storage",
+		},
+		{
+			resourceType: "azurerm_storage_sync_group",
+			expected:     "Microsoft.StorageSync",
+		},
+		{
+			resourceType: "azurerm_storage_mover_agent",
+			expected:     "Microsoft.StorageMover",
+		},
+		{
+			resourceType: "azurerm_log_analytics_workspace",
+			expected:     "Microsoft.OperationalInsights",
+		},
+		{
+			resourceType: "azurerm_log_analytics_solution",
+			expected:     "Microsoft.OperationsManagement",
+		},
+		{
+			resourceType: "azurerm_kubernetes_cluster_extension",
+			expected:     "Microsoft.KubernetesConfiguration",
+		},
+		{
+			resourceType: "azurerm_kubernetes_flux_configuration",
+			expected:     "Microsoft.KubernetesConfiguration",
+		},
+		{
+			resourceType: "azurerm_monitor_workspace",
+			expected:     "Microsoft.Monitor",
+		},
+		{
+			resourceType: "azurerm_resource_group",
+			expected:     "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q", tc.resourceType)
+
+		actual, _ := ResourceProviderForResourceType(tc.resourceType)
+		if actual != tc.expected {
+			t.Fatalf("expected %q but got %q", tc.expected, actual)
+		}
+	}
+}
+
+func TestValidateRegisteredForResourceType(t *testing.T) {
+	defer ClearCache()
+
+	subscriptionId := commonids.NewSubscriptionID("00000000-0000-0000-0000-000000000000")
+	otherSubscriptionId := commonids.NewSubscriptionID("11111111-1111-1111-1111-111111111111")
+
+	// the registration state is unknown, so no error should be raised
+	ClearCache()
+	if err := ValidateRegisteredForResourceType(subscriptionId, "azurerm_kubernetes_cluster"); err != nil {
+		t.Fatalf("expected no error when the registration state is unknown but got: %+v", err)
+	}
+
+	cacheLock.Lock()
+	cachedSubscriptions[cacheKey(subscriptionId)] = newCachedResourceProviders([]string{"Microsoft.Compute"}, []string{"Microsoft.ContainerService"})
+	cachedSubscriptions[cacheKey(otherSubscriptionId)] = newCachedResourceProviders([]string{"Microsoft.ContainerService"}, []string{"Microsoft.Compute"})
+	cacheLock.Unlock()
+
+	if err := ValidateRegisteredForResourceType(subscriptionId, "azurerm_linux_virtual_machine"); err != nil {
+		t.Fatalf("expected no error for a registered Resource Provider but got: %+v", err)
+	}
+
+	// the registration state in another Subscription shouldn't be used
+	if err := ValidateRegisteredForResourceType(otherSubscriptionId, "azurerm_kubernetes_cluster"); err != nil {
+		t.Fatalf("expected no error for a Resource Provider registered in the Subscription but got: %+v", err)
+	}
+
+	err := ValidateRegisteredForResourceType(subscriptionId, "azurerm_kubernetes_cluster")
+	if err == nil {
+		t.Fatalf("expected an error for an unregistered Resource Provider")
+	}
+	if !strings.Contains(err.Error(), "Microsoft.ContainerService") {
+		t.Fatalf("expected the error to name the Resource Provider but got: %+v", err)
+	}
+}
@@ -0,0 +1,1035 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+// resourceProvidersForResourceTypes is a map of the Terraform Resource Type to the Resource Provider it requires,
+// determined from the Resource ID which the Resource is imported using.
+var resourceProvidersForResourceTypes = map[string]string{
+	"azurerm_aadb2c_directory":                                                       "Microsoft.AzureActiveDirectory",
+	"azurerm_active_directory_domain_service":                                        "Microsoft.AAD",
+	"azurerm_active_directory_domain_service_replica_set":                            "Microsoft.AAD",
+	"azurerm_active_directory_domain_service_trust":                                  "Microsoft.AAD",
+	"azurerm_advanced_threat_protection":                                             "Microsoft.Security",
+	"azurerm_analysis_services_server":                                               "Microsoft.AnalysisServices",
+	"azurerm_api_connection":                                                         "Microsoft.Web",
+	"azurerm_api_management":                                                         "Microsoft.ApiManagement",
+	"azurerm_api_management_api":                                                     "Microsoft.ApiManagement",
+	"azurerm_api_management_api_diagnostic":                                          "Microsoft.ApiManagement",
+	"azurerm_api_management_api_operation":                                           "Microsoft.ApiManagement",
+	"azurerm_api_management_api_operation_policy":                                    "Microsoft.ApiManagement",
+	"azurerm_api_management_api_operation_tag":                                       "Microsoft.ApiManagement",
+	"azurerm_api_management_api_policy":                                              "Microsoft.ApiManagement",
+	"azurerm_api_management_api_release":                                             "Microsoft.ApiManagement",
+	"azurerm_api_management_api_schema":                                              "Microsoft.ApiManagement",
+	"azurerm_api_management_api_tag":                                                 "Microsoft.ApiManagement",
+	"azurerm_api_management_api_tag_description":                                     "Microsoft.ApiManagement",
+	"azurerm_api_management_api_version_set":                                         "Microsoft.ApiManagement",
+	"azurerm_api_management_authorization_server":                                    "Microsoft.ApiManagement",
+	"azurerm_api_management_backend":                                                 "Microsoft.ApiManagement",
+	"azurerm_api_management_certificate":                                             "Microsoft.ApiManagement",
+	"azurerm_api_management_custom_domain":                                           "Microsoft.ApiManagement",
+	"azurerm_api_management_diagnostic":                                              "Microsoft.ApiManagement",
+	"azurerm_api_management_gateway":                                                 "Microsoft.ApiManagement",
+	"azurerm_api_management_gateway_api":                                             "Microsoft.ApiManagement",
+	"azurerm_api_management_gateway_certificate_authority":                           "Microsoft.ApiManagement",
+	"azurerm_api_management_gateway_host_name_configuration":                         "Microsoft.ApiManagement",
+	"azurerm_api_management_group":                                                   "Microsoft.ApiManagement",
+	"azurerm_api_management_group_user":                                              "Microsoft.ApiManagement",
+	"azurerm_api_management_identity_provider_aad":                                   "Microsoft.ApiManagement",
+	"azurerm_api_management_identity_provider_aadb2c":                                "Microsoft.ApiManagement",
+	"azurerm_api_management_identity_provider_facebook":                              "Microsoft.ApiManagement",
+	"azurerm_api_management_identity_provider_google":                                "Microsoft.ApiManagement",
+	"azurerm_api_management_identity_provider_microsoft":                             "Microsoft.ApiManagement",
+	"azurerm_api_management_identity_provider_twitter":                               "Microsoft.ApiManagement",
+	"azurerm_api_management_logger":                                                  "Microsoft.ApiManagement",
+	"azurerm_api_management_named_value":                                             "Microsoft.ApiManagement",
+	"azurerm_api_management_notification_recipient_email":                            "Microsoft.ApiManagement",
+	"azurerm_api_management_notification_recipient_user":                             "Microsoft.ApiManagement",
+	"azurerm_api_management_openid_connect_provider":                                 "Microsoft.ApiManagement",
+	"azurerm_api_management_policy":                                                  "Microsoft.ApiManagement",
+	"azurerm_api_management_policy_fragment":                                         "Microsoft.ApiManagement",
+	"azurerm_api_management_product":                                                 "Microsoft.ApiManagement",
+	"azurerm_api_management_product_api":                                             "Microsoft.ApiManagement",
+	"azurerm_api_management_product_group":                                           "Microsoft.ApiManagement",
+	"azurerm_api_management_product_policy":                                          "Microsoft.ApiManagement",
+	"azurerm_api_management_product_tag":                                             "Microsoft.ApiManagement",
+	"azurerm_api_management_redis_cache":                                             "Microsoft.ApiManagement",
+	"azurerm_api_management_subscription":                                            "Microsoft.ApiManagement",
+	"azurerm_api_management_tag":                                                     "Microsoft.ApiManagement",
+	"azurerm_api_management_user":                                                    "Microsoft.ApiManagement",
+	"azurerm_app_configuration":                                                      "Microsoft.AppConfiguration",
+	"azurerm_app_service":                                                            "Microsoft.Web",
+	"azurerm_app_service_certificate":                                                "Microsoft.Web",
+	"azurerm_app_service_certificate_binding":                                        "Microsoft.Web",
+	"azurerm_app_service_certificate_order":                                          "Microsoft.CertificateRegistration",
+	"azurerm_app_service_connection":                                                 "Microsoft.ServiceLinker",
+	"azurerm_app_service_custom_hostname_binding":                                    "Microsoft.Web",
+	"azurerm_app_service_environment":                                                "Microsoft.Web",
+	"azurerm_app_service_environment_v3":                                             "Microsoft.Web",
+	"azurerm_app_service_hybrid_connection":                                          "Microsoft.Web",
+	"azurerm_app_service_managed_certificate":                                        "Microsoft.Web",
+	"azurerm_app_service_plan":                                                       "Microsoft.Web",
+	"azurerm_app_service_public_certificate":                                         "Microsoft.Web",
+	"azurerm_app_service_slot":                                                       "Microsoft.Web",
+	"azurerm_app_service_slot_custom_hostname_binding":                               "Microsoft.Web",
+	"azurerm_app_service_slot_virtual_network_swift_connection":                      "Microsoft.Web",
+	"azurerm_app_service_source_control":                                             "Microsoft.Web",
+	"azurerm_app_service_source_control_slot":                                        "Microsoft.Web",
+	"azurerm_app_service_virtual_network_swift_connection":                           "Microsoft.Web",
+	"azurerm_application_gateway":                                                    "Microsoft.Network",
+	"azurerm_application_gateway_backend_address_pool":                               "Microsoft.Network",
+	"azurerm_application_gateway_http_listener":                                      "Microsoft.Network",
+	"azurerm_application_gateway_probe":                                              "Microsoft.Network",
+	"azurerm_application_gateway_request_routing_rule":                               "Microsoft.Network",
+	"azurerm_application_gateway_ssl_certificate":                                    "Microsoft.Network",
+	"azurerm_application_insights":                                                   "Microsoft.Insights",
+	"azurerm_application_insights_analytics_item":                                    "Microsoft.Insights",
+	"azurerm_application_insights_api_key":                                           "Microsoft.Insights",
+	"azurerm_application_insights_smart_detection_rule":                              "Microsoft.Insights",
+	"azurerm_application_insights_standard_web_test":                                 "Microsoft.Insights",
+	"azurerm_application_insights_web_test":                                          "Microsoft.Insights",
+	"azurerm_application_insights_workbook":                                          "Microsoft.Insights",
+	"azurerm_application_insights_workbook_template":                                 "Microsoft.Insights",
+	"azurerm_application_load_balancer":                                              "Microsoft.ServiceNetworking",
+	"azurerm_application_load_balancer_frontend":                                     "Microsoft.ServiceNetworking",
+	"azurerm_application_load_balancer_subnet_association":                           "Microsoft.ServiceNetworking",
+	"azurerm_application_security_group":                                             "Microsoft.Network",
+	"azurerm_arc_kubernetes_cluster":                                                 "Microsoft.Kubernetes",
+	"azurerm_arc_kubernetes_cluster_extension":                                       "Microsoft.KubernetesConfiguration",
+	"azurerm_arc_kubernetes_flux_configuration":                                      "Microsoft.KubernetesConfiguration",
+	"azurerm_arc_machine_extension":                                                  "Microsoft.HybridCompute",
+	"azurerm_arc_private_link_scope":                                                 "Microsoft.HybridCompute",
+	"azurerm_arc_resource_bridge_appliance":                                          "Microsoft.ResourceConnector",
+	"azurerm_attestation_provider":                                                   "Microsoft.Attestation",
+	"azurerm_automanage_configuration":                                               "Microsoft.AutoManage",
+	"azurerm_automation_account":                                                     "Microsoft.Automation",
+	"azurerm_automation_certificate":                                                 "Microsoft.Automation",
+	"azurerm_automation_connection":                                                  "Microsoft.Automation",
+	"azurerm_automation_connection_certificate":                                      "Microsoft.Automation",
+	"azurerm_automation_connection_classic_certificate":                              "Microsoft.Automation",
+	"azurerm_automation_connection_service_principal":                                "Microsoft.Automation",
+	"azurerm_automation_connection_type":                                             "Microsoft.Automation",
+	"azurerm_automation_credential":                                                  "Microsoft.Automation",
+	"azurerm_automation_dsc_configuration":                                           "Microsoft.Automation",
+	"azurerm_automation_dsc_nodeconfiguration":                                       "Microsoft.Automation",
+	"azurerm_automation_hybrid_runbook_worker":                                       "Microsoft.Automation",
+	"azurerm_automation_hybrid_runbook_worker_group":                                 "Microsoft.Automation",
+	"azurerm_automation_job_schedule":                                                "Microsoft.Automation",
+	"azurerm_automation_module":                                                      "Microsoft.Automation",
+	"azurerm_automation_powershell72_module":                                         "Microsoft.Automation",
+	"azurerm_automation_python3_package":                                             "Microsoft.Automation",
+	"azurerm_automation_runbook":                                                     "Microsoft.Automation",
+	"azurerm_automation_schedule":                                                    "Microsoft.Automation",
+	"azurerm_automation_software_update_configuration":                               "Microsoft.Automation",
+	"azurerm_automation_source_control":                                              "Microsoft.Automation",
+	"azurerm_automation_variable_bool":                                               "Microsoft.Automation",
+	"azurerm_automation_variable_datetime":                                           "Microsoft.Automation",
+	"azurerm_automation_variable_int":                                                "Microsoft.Automation",
+	"azurerm_automation_variable_object":                                             "Microsoft.Automation",
+	"azurerm_automation_variable_string":                                             "Microsoft.Automation",
+	"azurerm_automation_watcher":                                                     "Microsoft.Automation",
+	"azurerm_automation_webhook":                                                     "Microsoft.Automation",
+	"azurerm_availability_set":                                                       "Microsoft.Compute",
+	"azurerm_backup_container_storage_account":                                       "Microsoft.RecoveryServices",
+	"azurerm_backup_policy_file_share":                                               "Microsoft.RecoveryServices",
+	"azurerm_backup_policy_vm":                                                       "Microsoft.RecoveryServices",
+	"azurerm_backup_policy_vm_workload":                                              "Microsoft.RecoveryServices",
+	"azurerm_backup_protected_file_share":                                            "Microsoft.RecoveryServices",
+	"azurerm_backup_protected_vm":                                                    "Microsoft.RecoveryServices",
+	"azurerm_bastion_host":                                                           "Microsoft.Network",
+	"azurerm_batch_account":                                                          "Microsoft.Batch",
+	"azurerm_batch_application":                                                      "Microsoft.Batch",
+	"azurerm_batch_certificate":                                                      "Microsoft.Batch",
+	"azurerm_batch_job":                                                              "Microsoft.Batch",
+	"azurerm_batch_pool":                                                             "Microsoft.Batch",
+	"azurerm_billing_account_cost_management_export":                                 "Microsoft.CostManagement",
+	"azurerm_blueprint_assignment":                                                   "Microsoft.Blueprint",
+	"azurerm_bot_channel_alexa":                                                      "Microsoft.BotService",
+	"azurerm_bot_channel_direct_line_speech":                                         "Microsoft.BotService",
+	"azurerm_bot_channel_directline":                                                 "Microsoft.BotService",
+	"azurerm_bot_channel_email":                                                      "Microsoft.BotService",
+	"azurerm_bot_channel_facebook":                                                   "Microsoft.BotService",
+	"azurerm_bot_channel_line":                                                       "Microsoft.BotService",
+	"azurerm_bot_channel_ms_teams":                                                   "Microsoft.BotService",
+	"azurerm_bot_channel_slack":                                                      "Microsoft.BotService",
+	"azurerm_bot_channel_sms":                                                        "Microsoft.BotService",
+	"azurerm_bot_channel_web_chat":                                                   "Microsoft.BotService",
+	"azurerm_bot_channels_registration":                                              "Microsoft.BotService",
+	"azurerm_bot_connection":                                                         "Microsoft.BotService",
+	"azurerm_bot_service_azure_bot":                                                  "Microsoft.BotService",
+	"azurerm_bot_web_app":                                                            "Microsoft.BotService",
+	"azurerm_capacity_reservation":                                                   "Microsoft.Compute",
+	"azurerm_capacity_reservation_group":                                             "Microsoft.Compute",
+	"azurerm_cdn_endpoint":                                                           "Microsoft.Cdn",
+	"azurerm_cdn_endpoint_custom_domain":                                             "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_custom_domain":                                            "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_custom_domain_association":                                "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_endpoint":                                                 "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_firewall_policy":                                          "Microsoft.Network",
+	"azurerm_cdn_frontdoor_origin":                                                   "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_origin_group":                                             "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_profile":                                                  "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_route":                                                    "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_route_disable_link_to_default_domain":                     "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_rule":                                                     "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_rule_set":                                                 "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_secret":                                                   "Microsoft.Cdn",
+	"azurerm_cdn_frontdoor_security_policy":                                          "Microsoft.Cdn",
+	"azurerm_cdn_profile":                                                            "Microsoft.Cdn",
+	"azurerm_chaos_studio_capability":                                                "Microsoft.Chaos",
+	"azurerm_chaos_studio_experiment":                                                "Microsoft.Chaos",
+	"azurerm_chaos_studio_target":                                                    "Microsoft.Chaos",
+	"azurerm_cognitive_account":                                                      "Microsoft.CognitiveServices",
+	"azurerm_cognitive_account_customer_managed_key":                                 "Microsoft.CognitiveServices",
+	"azurerm_cognitive_deployment":                                                   "Microsoft.CognitiveServices",
+	"azurerm_communication_service":                                                  "Microsoft.Communication",
+	"azurerm_confidential_ledger":                                                    "Microsoft.ConfidentialLedger",
+	"azurerm_consumption_budget_management_group":                                    "Microsoft.Consumption",
+	"azurerm_consumption_budget_resource_group":                                      "Microsoft.Consumption",
+	"azurerm_consumption_budget_subscription":                                        "Microsoft.Consumption",
+	"azurerm_container_app":                                                          "Microsoft.App",
+	"azurerm_container_app_custom_domain":                                            "Microsoft.App",
+	"azurerm_container_app_environment":                                              "Microsoft.App",
+	"azurerm_container_app_environment_certificate":                                  "Microsoft.App",
+	"azurerm_container_app_environment_custom_domain":                                "Microsoft.App",
+	"azurerm_container_app_environment_dapr_component":                               "Microsoft.App",
+	"azurerm_container_app_environment_storage":                                      "Microsoft.App",
+	"azurerm_container_app_job":                                                      "Microsoft.App",
+	"azurerm_container_connected_registry":                                           "Microsoft.ContainerRegistry",
+	"azurerm_container_group":                                                        "Microsoft.ContainerInstance",
+	"azurerm_container_registry":                                                     "Microsoft.ContainerRegistry",
+	"azurerm_container_registry_agent_pool":                                          "Microsoft.ContainerRegistry",
+	"azurerm_container_registry_cache_rule":                                          "Microsoft.ContainerRegistry",
+	"azurerm_container_registry_scope_map":                                           "Microsoft.ContainerRegistry",
+	"azurerm_container_registry_task":                                                "Microsoft.ContainerRegistry",
+	"azurerm_container_registry_token":                                               "Microsoft.ContainerRegistry",
+	"azurerm_container_registry_token_password":                                      "Microsoft.ContainerRegistry",
+	"azurerm_container_registry_webhook":                                             "Microsoft.ContainerRegistry",
+	"azurerm_cosmosdb_account":                                                       "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_cassandra_cluster":                                             "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_cassandra_datacenter":                                          "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_cassandra_keyspace":                                            "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_cassandra_table":                                               "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_gremlin_database":                                              "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_gremlin_graph":                                                 "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_mongo_collection":                                              "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_mongo_database":                                                "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_mongo_role_definition":                                         "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_mongo_user_definition":                                         "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_notebook_workspace":                                            "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_postgresql_cluster":                                            "Microsoft.DBforPostgreSQL",
+	"azurerm_cosmosdb_postgresql_coordinator_configuration":                          "Microsoft.DBforPostgreSQL",
+	"azurerm_cosmosdb_postgresql_firewall_rule":                                      "Microsoft.DBforPostgreSQL",
+	"azurerm_cosmosdb_postgresql_node_configuration":                                 "Microsoft.DBforPostgreSQL",
+	"azurerm_cosmosdb_postgresql_role":                                               "Microsoft.DBforPostgreSQL",
+	"azurerm_cosmosdb_sql_container":                                                 "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_sql_database":                                                  "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_sql_dedicated_gateway":                                         "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_sql_function":                                                  "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_sql_role_assignment":                                           "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_sql_role_definition":                                           "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_sql_stored_procedure":                                          "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_sql_trigger":                                                   "Microsoft.DocumentDB",
+	"azurerm_cosmosdb_table":                                                         "Microsoft.DocumentDB",
+	"azurerm_cost_anomaly_alert":                                                     "Microsoft.CostManagement",
+	"azurerm_cost_management_scheduled_action":                                       "Microsoft.CostManagement",
+	"azurerm_custom_ip_prefix":                                                       "Microsoft.Network",
+	"azurerm_custom_provider":                                                        "Microsoft.CustomProviders",
+	"azurerm_dashboard":                                                              "Microsoft.Portal",
+	"azurerm_dashboard_grafana":                                                      "Microsoft.Dashboard",
+	"azurerm_data_factory":                                                           "Microsoft.DataFactory",
+	"azurerm_data_factory_credential_service_principal":                              "Microsoft.DataFactory",
+	"azurerm_data_factory_custom_dataset":                                            "Microsoft.DataFactory",
+	"azurerm_data_factory_data_flow":                                                 "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_azure_blob":                                        "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_azure_sql_table":                                   "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_binary":                                            "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_cosmosdb_sqlapi":                                   "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_delimited_text":                                    "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_http":                                              "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_json":                                              "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_mysql":                                             "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_parquet":                                           "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_postgresql":                                        "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_snowflake":                                         "Microsoft.DataFactory",
+	"azurerm_data_factory_dataset_sql_server_table":                                  "Microsoft.DataFactory",
+	"azurerm_data_factory_flowlet_data_flow":                                         "Microsoft.DataFactory",
+	"azurerm_data_factory_integration_runtime_azure":                                 "Microsoft.DataFactory",
+	"azurerm_data_factory_integration_runtime_azure_ssis":                            "Microsoft.DataFactory",
+	"azurerm_data_factory_integration_runtime_managed":                               "Microsoft.DataFactory",
+	"azurerm_data_factory_integration_runtime_self_hosted":                           "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_custom_service":                                     "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_azure_blob_storage":                         "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_azure_databricks":                           "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_azure_file_storage":                         "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_azure_function":                             "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_azure_search":                               "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_azure_sql_database":                         "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_azure_table_storage":                        "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_cosmosdb":                                   "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_cosmosdb_mongoapi":                          "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_data_lake_storage_gen2":                     "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_key_vault":                                  "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_kusto":                                      "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_mysql":                                      "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_odata":                                      "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_odbc":                                       "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_postgresql":                                 "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_sftp":                                       "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_snowflake":                                  "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_sql_server":                                 "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_synapse":                                    "Microsoft.DataFactory",
+	"azurerm_data_factory_linked_service_web":                                        "Microsoft.DataFactory",
+	"azurerm_data_factory_managed_private_endpoint":                                  "Microsoft.DataFactory",
+	"azurerm_data_factory_pipeline":                                                  "Microsoft.DataFactory",
+	"azurerm_data_factory_trigger_blob_event":                                        "Microsoft.DataFactory",
+	"azurerm_data_factory_trigger_custom_event":                                      "Microsoft.DataFactory",
+	"azurerm_data_factory_trigger_schedule":                                          "Microsoft.DataFactory",
+	"azurerm_data_protection_backup_instance_kubernetes_cluster":                     "Microsoft.DataProtection",
+	"azurerm_data_protection_backup_policy_kubernetes_cluster":                       "Microsoft.DataProtection",
+	"azurerm_data_protection_backup_policy_postgresql":                               "Microsoft.DataProtection",
+	"azurerm_data_protection_backup_vault":                                           "Microsoft.DataProtection",
+	"azurerm_data_protection_resource_guard":                                         "Microsoft.DataProtection",
+	"azurerm_data_share":                                                             "Microsoft.DataShare",
+	"azurerm_data_share_account":                                                     "Microsoft.DataShare",
+	"azurerm_data_share_dataset_blob_storage":                                        "Microsoft.DataShare",
+	"azurerm_data_share_dataset_data_lake_gen2":                                      "Microsoft.DataShare",
+	"azurerm_data_share_dataset_kusto_cluster":                                       "Microsoft.DataShare",
+	"azurerm_data_share_dataset_kusto_database":                                      "Microsoft.DataShare",
+	"azurerm_database_migration_project":                                             "Microsoft.DataMigration",
+	"azurerm_database_migration_service":                                             "Microsoft.DataMigration",
+	"azurerm_databox_edge_device":                                                    "Microsoft.DataBoxEdge",
+	"azurerm_databox_edge_order":                                                     "Microsoft.DataBoxEdge",
+	"azurerm_databricks_access_connector":                                            "Microsoft.Databricks",
+	"azurerm_databricks_virtual_network_peering":                                     "Microsoft.Databricks",
+	"azurerm_databricks_workspace":                                                   "Microsoft.Databricks",
+	"azurerm_databricks_workspace_customer_managed_key":                              "Microsoft.Databricks",
+	"azurerm_databricks_workspace_root_dbfs_customer_managed_key":                    "Microsoft.Databricks",
+	"azurerm_datadog_monitor_sso_configuration":                                      "Microsoft.Datadog",
+	"azurerm_datadog_monitor_tag_rule":                                               "Microsoft.Datadog",
+	"azurerm_dedicated_hardware_security_module":                                     "Microsoft.HardwareSecurityModules",
+	"azurerm_dedicated_host":                                                         "Microsoft.Compute",
+	"azurerm_dedicated_host_group":                                                   "Microsoft.Compute",
+	"azurerm_dev_center":                                                             "Microsoft.DevCenter",
+	"azurerm_dev_center_gallery":                                                     "Microsoft.DevCenter",
+	"azurerm_dev_center_project":                                                     "Microsoft.DevCenter",
+	"azurerm_dev_test_global_vm_shutdown_schedule":                                   "Microsoft.DevTestLab",
+	"azurerm_dev_test_lab":                                                           "Microsoft.DevTestLab",
+	"azurerm_dev_test_linux_virtual_machine":                                         "Microsoft.DevTestLab",
+	"azurerm_dev_test_policy":                                                        "Microsoft.DevTestLab",
+	"azurerm_dev_test_schedule":                                                      "Microsoft.DevTestLab",
+	"azurerm_dev_test_virtual_network":                                               "Microsoft.DevTestLab",
+	"azurerm_dev_test_windows_virtual_machine":                                       "Microsoft.DevTestLab",
+	"azurerm_digital_twins_endpoint_eventgrid":                                       "Microsoft.DigitalTwins",
+	"azurerm_digital_twins_endpoint_eventhub":                                        "Microsoft.DigitalTwins",
+	"azurerm_digital_twins_endpoint_servicebus":                                      "Microsoft.DigitalTwins",
+	"azurerm_digital_twins_instance":                                                 "Microsoft.DigitalTwins",
+	"azurerm_digital_twins_time_series_database_connection":                          "Microsoft.DigitalTwins",
+	"azurerm_disk_access":                                                            "Microsoft.Compute",
+	"azurerm_disk_encryption_set":                                                    "Microsoft.Compute",
+	"azurerm_disk_pool":                                                              "Microsoft.StoragePool",
+	"azurerm_disk_pool_iscsi_target":                                                 "Microsoft.StoragePool",
+	"azurerm_disk_pool_iscsi_target_lun":                                             "Microsoft.Compute",
+	"azurerm_disk_pool_managed_disk_attachment":                                      "Microsoft.Compute",
+	"azurerm_dns_a_record":                                                           "Microsoft.Network",
+	"azurerm_dns_aaaa_record":                                                        "Microsoft.Network",
+	"azurerm_dns_caa_record":                                                         "Microsoft.Network",
+	"azurerm_dns_cname_record":                                                       "Microsoft.Network",
+	"azurerm_dns_mx_record":                                                          "Microsoft.Network",
+	"azurerm_dns_ns_record":                                                          "Microsoft.Network",
+	"azurerm_dns_ptr_record":                                                         "Microsoft.Network",
+	"azurerm_dns_srv_record":                                                         "Microsoft.Network",
+	"azurerm_dns_txt_record":                                                         "Microsoft.Network",
+	"azurerm_dns_zone":                                                               "Microsoft.Network",
+	"azurerm_elastic_cloud_elasticsearch":                                            "Microsoft.Elastic",
+	"azurerm_elastic_san":                                                            "Microsoft.ElasticSan",
+	"azurerm_elastic_san_volume":                                                     "Microsoft.ElasticSan",
+	"azurerm_elastic_san_volume_group":                                               "Microsoft.ElasticSan",
+	"azurerm_email_communication_service":                                            "Microsoft.Communication",
+	"azurerm_eventgrid_domain":                                                       "Microsoft.EventGrid",
+	"azurerm_eventgrid_domain_topic":                                                 "Microsoft.EventGrid",
+	"azurerm_eventgrid_event_subscription":                                           "Microsoft.EventGrid",
+	"azurerm_eventgrid_system_topic":                                                 "Microsoft.EventGrid",
+	"azurerm_eventgrid_system_topic_event_subscription":                              "Microsoft.EventGrid",
+	"azurerm_eventgrid_topic":                                                        "Microsoft.EventGrid",
+	"azurerm_eventhub":                                                               "Microsoft.EventHub",
+	"azurerm_eventhub_authorization_rule":                                            "Microsoft.EventHub",
+	"azurerm_eventhub_cluster":                                                       "Microsoft.EventHub",
+	"azurerm_eventhub_consumer_group":                                                "Microsoft.EventHub",
+	"azurerm_eventhub_namespace":                                                     "Microsoft.EventHub",
+	"azurerm_eventhub_namespace_authorization_rule":                                  "Microsoft.EventHub",
+	"azurerm_eventhub_namespace_customer_managed_key":                                "Microsoft.EventHub",
+	"azurerm_eventhub_namespace_disaster_recovery_config":                            "Microsoft.EventHub",
+	"azurerm_eventhub_namespace_schema_group":                                        "Microsoft.EventHub",
+	"azurerm_express_route_circuit":                                                  "Microsoft.Network",
+	"azurerm_express_route_circuit_authorization":                                    "Microsoft.Network",
+	"azurerm_express_route_circuit_connection":                                       "Microsoft.Network",
+	"azurerm_express_route_circuit_peering":                                          "Microsoft.Network",
+	"azurerm_express_route_connection":                                               "Microsoft.Network",
+	"azurerm_express_route_gateway":                                                  "Microsoft.Network",
+	"azurerm_express_route_port":                                                     "Microsoft.Network",
+	"azurerm_express_route_port_authorization":                                       "Microsoft.Network",
+	"azurerm_federated_identity_credential":                                          "Microsoft.ManagedIdentity",
+	"azurerm_firewall":                                                               "Microsoft.Network",
+	"azurerm_firewall_application_rule_collection":                                   "Microsoft.Network",
+	"azurerm_firewall_nat_rule_collection":                                           "Microsoft.Network",
+	"azurerm_firewall_network_rule_collection":                                       "Microsoft.Network",
+	"azurerm_firewall_policy":                                                        "Microsoft.Network",
+	"azurerm_firewall_policy_rule_collection_group":                                  "Microsoft.Network",
+	"azurerm_frontdoor":                                                              "Microsoft.Network",
+	"azurerm_frontdoor_custom_https_configuration":                                   "Microsoft.Network",
+	"azurerm_frontdoor_firewall_policy":                                              "Microsoft.Network",
+	"azurerm_frontdoor_rules_engine":                                                 "Microsoft.Network",
+	"azurerm_function_app":                                                           "Microsoft.Web",
+	"azurerm_function_app_active_slot":                                               "Microsoft.Web",
+	"azurerm_function_app_connection":                                                "Microsoft.ServiceLinker",
+	"azurerm_function_app_function":                                                  "Microsoft.Web",
+	"azurerm_function_app_hybrid_connection":                                         "Microsoft.Web",
+	"azurerm_function_app_slot":                                                      "Microsoft.Web",
+	"azurerm_gallery_application":                                                    "Microsoft.Compute",
+	"azurerm_gallery_application_version":                                            "Microsoft.Compute",
+	"azurerm_graph_account":                                                          "Microsoft.GraphServices",
+	"azurerm_graph_services_account":                                                 "Microsoft.GraphServices",
+	"azurerm_hdinsight_hadoop_cluster":                                               "Microsoft.HDInsight",
+	"azurerm_hdinsight_hbase_cluster":                                                "Microsoft.HDInsight",
+	"azurerm_hdinsight_interactive_query_cluster":                                    "Microsoft.HDInsight",
+	"azurerm_hdinsight_kafka_cluster":                                                "Microsoft.HDInsight",
+	"azurerm_hdinsight_spark_cluster":                                                "Microsoft.HDInsight",
+	"azurerm_healthcare_fhir_service":                                                "Microsoft.HealthcareApis",
+	"azurerm_healthcare_medtech_service":                                             "Microsoft.HealthcareApis",
+	"azurerm_healthcare_medtech_service_fhir_destination":                            "Microsoft.HealthcareApis",
+	"azurerm_healthcare_service":                                                     "Microsoft.HealthcareApis",
+	"azurerm_healthcare_workspace":                                                   "Microsoft.HealthcareApis",
+	"azurerm_hpc_cache":                                                              "Microsoft.StorageCache",
+	"azurerm_hpc_cache_access_policy":                                                "Microsoft.StorageCache",
+	"azurerm_hpc_cache_blob_nfs_target":                                              "Microsoft.StorageCache",
+	"azurerm_hpc_cache_blob_target":                                                  "Microsoft.StorageCache",
+	"azurerm_hpc_cache_nfs_target":                                                   "Microsoft.StorageCache",
+	"azurerm_image":                                                                  "Microsoft.Compute",
+	"azurerm_integration_service_environment":                                        "Microsoft.Logic",
+	"azurerm_iot_security_device_group":                                              "Microsoft.Security",
+	"azurerm_iot_security_solution":                                                  "Microsoft.Security",
+	"azurerm_iot_time_series_insights_access_policy":                                 "Microsoft.TimeSeriesInsights",
+	"azurerm_iot_time_series_insights_event_source_eventhub":                         "Microsoft.TimeSeriesInsights",
+	"azurerm_iot_time_series_insights_event_source_iothub":                           "Microsoft.TimeSeriesInsights",
+	"azurerm_iot_time_series_insights_gen2_environment":                              "Microsoft.TimeSeriesInsights",
+	"azurerm_iot_time_series_insights_reference_data_set":                            "Microsoft.TimeSeriesInsights",
+	"azurerm_iot_time_series_insights_standard_environment":                          "Microsoft.TimeSeriesInsights",
+	"azurerm_iotcentral_application":                                                 "Microsoft.IoTCentral",
+	"azurerm_iotcentral_application_network_rule_set":                                "Microsoft.IoTCentral",
+	"azurerm_iotcentral_organization":                                                "Microsoft.IoTCentral",
+	"azurerm_iothub":                                                                 "Microsoft.Devices",
+	"azurerm_iothub_certificate":                                                     "Microsoft.Devices",
+	"azurerm_iothub_consumer_group":                                                  "Microsoft.Devices",
+	"azurerm_iothub_device_update_account":                                           "Microsoft.DeviceUpdate",
+	"azurerm_iothub_device_update_instance":                                          "Microsoft.DeviceUpdate",
+	"azurerm_iothub_dps":                                                             "Microsoft.Devices",
+	"azurerm_iothub_dps_certificate":                                                 "Microsoft.Devices",
+	"azurerm_iothub_dps_shared_access_policy":                                        "Microsoft.Devices",
+	"azurerm_iothub_endpoint_cosmosdb_account":                                       "Microsoft.Devices",
+	"azurerm_iothub_endpoint_eventhub":                                               "Microsoft.Devices",
+	"azurerm_iothub_endpoint_servicebus_queue":                                       "Microsoft.Devices",
+	"azurerm_iothub_endpoint_servicebus_topic":                                       "Microsoft.Devices",
+	"azurerm_iothub_endpoint_storage_container":                                      "Microsoft.Devices",
+	"azurerm_iothub_enrichment":                                                      "Microsoft.Devices",
+	"azurerm_iothub_fallback_route":                                                  "Microsoft.Devices",
+	"azurerm_iothub_file_upload":                                                     "Microsoft.Devices",
+	"azurerm_iothub_route":                                                           "Microsoft.Devices",
+	"azurerm_iothub_shared_access_policy":                                            "Microsoft.Devices",
+	"azurerm_ip_group":                                                               "Microsoft.Network",
+	"azurerm_ip_group_cidr":                                                          "Microsoft.Network",
+	"azurerm_key_vault":                                                              "Microsoft.KeyVault",
+	"azurerm_key_vault_access_policy":                                                "Microsoft.KeyVault",
+	"azurerm_key_vault_managed_hardware_security_module":                             "Microsoft.KeyVault",
+	"azurerm_kubernetes_cluster":                                                     "Microsoft.ContainerService",
+	"azurerm_kubernetes_cluster_extension":                                           "Microsoft.KubernetesConfiguration",
+	"azurerm_kubernetes_cluster_node_pool":                                           "Microsoft.ContainerService",
+	"azurerm_kubernetes_cluster_trusted_access_role_binding":                         "Microsoft.ContainerService",
+	"azurerm_kubernetes_fleet_manager":                                               "Microsoft.ContainerService",
+	"azurerm_kubernetes_fleet_member":                                                "Microsoft.ContainerService",
+	"azurerm_kubernetes_fleet_update_run":                                            "Microsoft.ContainerService",
+	"azurerm_kubernetes_fleet_update_strategy":                                       "Microsoft.ContainerService",
+	"azurerm_kubernetes_flux_configuration":                                          "Microsoft.KubernetesConfiguration",
+	"azurerm_kusto_attached_database_configuration":                                  "Microsoft.Kusto",
+	"azurerm_kusto_cluster":                                                          "Microsoft.Kusto",
+	"azurerm_kusto_cluster_customer_managed_key":                                     "Microsoft.Kusto",
+	"azurerm_kusto_cluster_managed_private_endpoint":                                 "Microsoft.Kusto",
+	"azurerm_kusto_cluster_principal_assignment":                                     "Microsoft.Kusto",
+	"azurerm_kusto_cosmosdb_data_connection":                                         "Microsoft.Kusto",
+	"azurerm_kusto_database":                                                         "Microsoft.Kusto",
+	"azurerm_kusto_database_principal_assignment":                                    "Microsoft.Kusto",
+	"azurerm_kusto_eventgrid_data_connection":                                        "Microsoft.Kusto",
+	"azurerm_kusto_eventhub_data_connection":                                         "Microsoft.Kusto",
+	"azurerm_kusto_iothub_data_connection":                                           "Microsoft.Kusto",
+	"azurerm_kusto_script":                                                           "Microsoft.Kusto",
+	"azurerm_lab_service_lab":                                                        "Microsoft.LabServices",
+	"azurerm_lab_service_plan":                                                       "Microsoft.LabServices",
+	"azurerm_lab_service_schedule":                                                   "Microsoft.LabServices",
+	"azurerm_lab_service_user":                                                       "Microsoft.LabServices",
+	"azurerm_lb":                                                                     "Microsoft.Network",
+	"azurerm_lb_backend_address_pool":                                                "Microsoft.Network",
+	"azurerm_lb_backend_address_pool_address":                                        "Microsoft.Network",
+	"azurerm_lb_nat_pool":                                                            "Microsoft.Network",
+	"azurerm_lb_nat_rule":                                                            "Microsoft.Network",
+	"azurerm_lb_outbound_rule":                                                       "Microsoft.Network",
+	"azurerm_lb_probe":                                                               "Microsoft.Network",
+	"azurerm_lb_rule":                                                                "Microsoft.Network",
+	"azurerm_lighthouse_assignment":                                                  "Microsoft.ManagedServices",
+	"azurerm_lighthouse_definition":                                                  "Microsoft.ManagedServices",
+	"azurerm_linux_function_app":                                                     "Microsoft.Web",
+	"azurerm_linux_function_app_slot":                                                "Microsoft.Web",
+	"azurerm_linux_virtual_machine":                                                  "Microsoft.Compute",
+	"azurerm_linux_virtual_machine_scale_set":                                        "Microsoft.Compute",
+	"azurerm_linux_web_app":                                                          "Microsoft.Web",
+	"azurerm_linux_web_app_slot":                                                     "Microsoft.Web",
+	"azurerm_load_test":                                                              "Microsoft.LoadTestService",
+	"azurerm_local_network_gateway":                                                  "Microsoft.Network",
+	"azurerm_log_analytics_cluster":                                                  "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_cluster_customer_managed_key":                             "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_data_export_rule":                                         "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_datasource_windows_event":                                 "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_datasource_windows_performance_counter":                   "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_linked_service":                                           "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_query_pack":                                               "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_query_pack_query":                                         "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_saved_search":                                             "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_solution":                                                 "Microsoft.OperationsManagement",
+	"azurerm_log_analytics_storage_insights":                                         "Microsoft.OperationalInsights",
+	"azurerm_log_analytics_workspace":                                                "Microsoft.OperationalInsights",
+	"azurerm_logic_app_action_custom":                                                "Microsoft.Logic",
+	"azurerm_logic_app_action_http":                                                  "Microsoft.Logic",
+	"azurerm_logic_app_integration_account":                                          "Microsoft.Logic",
+	"azurerm_logic_app_integration_account_agreement":                                "Microsoft.Logic",
+	"azurerm_logic_app_integration_account_assembly":                                 "Microsoft.Logic",
+	"azurerm_logic_app_integration_account_batch_configuration":                      "Microsoft.Logic",
+	"azurerm_logic_app_integration_account_certificate":                              "Microsoft.Logic",
+	"azurerm_logic_app_integration_account_map":                                      "Microsoft.Logic",
+	"azurerm_logic_app_integration_account_partner":                                  "Microsoft.Logic",
+	"azurerm_logic_app_integration_account_schema":                                   "Microsoft.Logic",
+	"azurerm_logic_app_integration_account_session":                                  "Microsoft.Logic",
+	"azurerm_logic_app_standard":                                                     "Microsoft.Web",
+	"azurerm_logic_app_trigger_custom":                                               "Microsoft.Logic",
+	"azurerm_logic_app_trigger_http_request":                                         "Microsoft.Logic",
+	"azurerm_logic_app_trigger_recurrence":                                           "Microsoft.Logic",
+	"azurerm_logic_app_workflow":                                                     "Microsoft.Logic",
+	"azurerm_logz_monitor":                                                           "Microsoft.Logz",
+	"azurerm_logz_sub_account":                                                       "Microsoft.Logz",
+	"azurerm_logz_sub_account_tag_rule":                                              "Microsoft.Logz",
+	"azurerm_logz_tag_rule":                                                          "Microsoft.Logz",
+	"azurerm_machine_learning_compute_cluster":                                       "Microsoft.MachineLearningServices",
+	"azurerm_machine_learning_compute_instance":                                      "Microsoft.MachineLearningServices",
+	"azurerm_machine_learning_datastore_blobstorage":                                 "Microsoft.MachineLearningServices",
+	"azurerm_machine_learning_datastore_datalake_gen2":                               "Microsoft.MachineLearningServices",
+	"azurerm_machine_learning_datastore_fileshare":                                   "Microsoft.MachineLearningServices",
+	"azurerm_machine_learning_inference_cluster":                                     "Microsoft.MachineLearningServices",
+	"azurerm_machine_learning_synapse_spark":                                         "Microsoft.MachineLearningServices",
+	"azurerm_machine_learning_workspace":                                             "Microsoft.MachineLearningServices",
+	"azurerm_maintenance_assignment_dedicated_host":                                  "Microsoft.Maintenance",
+	"azurerm_maintenance_assignment_dynamic_scope":                                   "Microsoft.Maintenance",
+	"azurerm_maintenance_assignment_virtual_machine":                                 "Microsoft.Maintenance",
+	"azurerm_maintenance_assignment_virtual_machine_scale_set":                       "Microsoft.Maintenance",
+	"azurerm_maintenance_configuration":                                              "Microsoft.Maintenance",
+	"azurerm_managed_application":                                                    "Microsoft.Solutions",
+	"azurerm_managed_application_definition":                                         "Microsoft.Solutions",
+	"azurerm_managed_disk":                                                           "Microsoft.Compute",
+	"azurerm_managed_lustre_file_system":                                             "Microsoft.StorageCache",
+	"azurerm_management_group":                                                       "Microsoft.Management",
+	"azurerm_management_group_policy_assignment":                                     "Microsoft.Authorization",
+	"azurerm_management_group_policy_remediation":                                    "Microsoft.PolicyInsights",
+	"azurerm_management_group_template_deployment":                                   "Microsoft.Resources",
+	"azurerm_management_lock":                                                        "Microsoft.Authorization",
+	"azurerm_maps_account":                                                           "Microsoft.Maps",
+	"azurerm_maps_creator":                                                           "Microsoft.Maps",
+	"azurerm_mariadb_configuration":                                                  "Microsoft.DBforMariaDB",
+	"azurerm_mariadb_database":                                                       "Microsoft.DBforMariaDB",
+	"azurerm_mariadb_firewall_rule":                                                  "Microsoft.DBforMariaDB",
+	"azurerm_mariadb_server":                                                         "Microsoft.DBforMariaDB",
+	"azurerm_mariadb_virtual_network_rule":                                           "Microsoft.DBforMariaDB",
+	"azurerm_marketplace_agreement":                                                  "Microsoft.MarketplaceOrdering",
+	"azurerm_media_asset":                                                            "Microsoft.Media",
+	"azurerm_media_asset_filter":                                                     "Microsoft.Media",
+	"azurerm_media_content_key_policy":                                               "Microsoft.Media",
+	"azurerm_media_job":                                                              "Microsoft.Media",
+	"azurerm_media_live_event":                                                       "Microsoft.Media",
+	"azurerm_media_services_account":                                                 "Microsoft.Media",
+	"azurerm_media_services_account_filter":                                          "Microsoft.Media",
+	"azurerm_media_streaming_endpoint":                                               "Microsoft.Media",
+	"azurerm_media_streaming_locator":                                                "Microsoft.Media",
+	"azurerm_media_streaming_policy":                                                 "Microsoft.Media",
+	"azurerm_media_transform":                                                        "Microsoft.Media",
+	"azurerm_mobile_network":                                                         "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_attached_data_network":                                   "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_data_network":                                            "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_packet_core_control_plane":                               "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_packet_core_data_plane":                                  "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_service":                                                 "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_sim":                                                     "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_sim_group":                                               "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_sim_policy":                                              "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_site":                                                    "Microsoft.MobileNetwork",
+	"azurerm_mobile_network_slice":                                                   "Microsoft.MobileNetwork",
+	"azurerm_monitor_aad_diagnostic_setting":                                         "Microsoft.AADIAM",
+	"azurerm_monitor_action_group":                                                   "Microsoft.Insights",
+	"azurerm_monitor_action_rule_action_group":                                       "Microsoft.AlertsManagement",
+	"azurerm_monitor_action_rule_suppression":                                        "Microsoft.AlertsManagement",
+	"azurerm_monitor_activity_log_alert":                                             "Microsoft.Insights",
+	"azurerm_monitor_alert_processing_rule_action_group":                             "Microsoft.AlertsManagement",
+	"azurerm_monitor_alert_processing_rule_suppression":                              "Microsoft.AlertsManagement",
+	"azurerm_monitor_alert_prometheus_rule_group":                                    "Microsoft.AlertsManagement",
+	"azurerm_monitor_autoscale_setting":                                              "Microsoft.Insights",
+	"azurerm_monitor_data_collection_endpoint":                                       "Microsoft.Insights",
+	"azurerm_monitor_data_collection_rule":                                           "Microsoft.Insights",
+	"azurerm_monitor_data_collection_rule_association":                               "Microsoft.Insights",
+	"azurerm_monitor_diagnostic_setting":                                             "Microsoft.KeyVault",
+	"azurerm_monitor_log_profile":                                                    "Microsoft.Insights",
+	"azurerm_monitor_metric_alert":                                                   "Microsoft.Insights",
+	"azurerm_monitor_private_link_scope":                                             "Microsoft.Insights",
+	"azurerm_monitor_private_link_scoped_service":                                    "Microsoft.Insights",
+	"azurerm_monitor_scheduled_query_rules_alert":                                    "Microsoft.Insights",
+	"azurerm_monitor_scheduled_query_rules_alert_v2":                                 "Microsoft.Insights",
+	"azurerm_monitor_scheduled_query_rules_log":                                      "Microsoft.Insights",
+	"azurerm_monitor_smart_detector_alert_rule":                                      "Microsoft.AlertsManagement",
+	"azurerm_monitor_workspace":                                                      "Microsoft.Monitor",
+	"azurerm_mssql_database":                                                         "Microsoft.Sql",
+	"azurerm_mssql_database_extended_auditing_policy":                                "Microsoft.Sql",
+	"azurerm_mssql_database_vulnerability_assessment_rule_baseline":                  "Microsoft.Sql",
+	"azurerm_mssql_elasticpool":                                                      "Microsoft.Sql",
+	"azurerm_mssql_failover_group":                                                   "Microsoft.Sql",
+	"azurerm_mssql_firewall_rule":                                                    "Microsoft.Sql",
+	"azurerm_mssql_job_agent":                                                        "Microsoft.Sql",
+	"azurerm_mssql_job_credential":                                                   "Microsoft.Sql",
+	"azurerm_mssql_managed_database":                                                 "Microsoft.Sql",
+	"azurerm_mssql_managed_instance":                                                 "Microsoft.Sql",
+	"azurerm_mssql_managed_instance_active_directory_administrator":                  "Microsoft.Sql",
+	"azurerm_mssql_managed_instance_failover_group":                                  "Microsoft.Sql",
+	"azurerm_mssql_managed_instance_security_alert_policy":                           "Microsoft.Sql",
+	"azurerm_mssql_managed_instance_transparent_data_encryption":                     "Microsoft.Sql",
+	"azurerm_mssql_managed_instance_vulnerability_assessment":                        "Microsoft.Sql",
+	"azurerm_mssql_outbound_firewall_rule":                                           "Microsoft.Sql",
+	"azurerm_mssql_server":                                                           "Microsoft.Sql",
+	"azurerm_mssql_server_dns_alias":                                                 "Microsoft.Sql",
+	"azurerm_mssql_server_extended_auditing_policy":                                  "Microsoft.Sql",
+	"azurerm_mssql_server_microsoft_support_auditing_policy":                         "Microsoft.Sql",
+	"azurerm_mssql_server_security_alert_policy":                                     "Microsoft.Sql",
+	"azurerm_mssql_server_transparent_data_encryption":                               "Microsoft.Sql",
+	"azurerm_mssql_server_vulnerability_assessment":                                  "Microsoft.Sql",
+	"azurerm_mssql_virtual_machine":                                                  "Microsoft.SqlVirtualMachine",
+	"azurerm_mssql_virtual_machine_availability_group_listener":                      "Microsoft.SqlVirtualMachine",
+	"azurerm_mssql_virtual_machine_group":                                            "Microsoft.SqlVirtualMachine",
+	"azurerm_mssql_virtual_network_rule":                                             "Microsoft.Sql",
+	"azurerm_mysql_active_directory_administrator":                                   "Microsoft.DBforMySQL",
+	"azurerm_mysql_configuration":                                                    "Microsoft.DBforMySQL",
+	"azurerm_mysql_database":                                                         "Microsoft.DBforMySQL",
+	"azurerm_mysql_firewall_rule":                                                    "Microsoft.DBforMySQL",
+	"azurerm_mysql_flexible_database":                                                "Microsoft.DBforMySQL",
+	"azurerm_mysql_flexible_server":                                                  "Microsoft.DBforMySQL",
+	"azurerm_mysql_flexible_server_configuration":                                    "Microsoft.DBforMySQL",
+	"azurerm_mysql_flexible_server_firewall_rule":                                    "Microsoft.DBforMySQL",
+	"azurerm_mysql_server":                                                           "Microsoft.DBforMySQL",
+	"azurerm_mysql_server_key":                                                       "Microsoft.DBforMySQL",
+	"azurerm_mysql_virtual_network_rule":                                             "Microsoft.DBforMySQL",
+	"azurerm_nat_gateway":                                                            "Microsoft.Network",
+	"azurerm_nat_gateway_public_ip_association":                                      "Microsoft.Network",
+	"azurerm_nat_gateway_public_ip_prefix_association":                               "Microsoft.Network",
+	"azurerm_netapp_account":                                                         "Microsoft.NetApp",
+	"azurerm_netapp_account_encryption":                                              "Microsoft.NetApp",
+	"azurerm_netapp_pool":                                                            "Microsoft.NetApp",
+	"azurerm_netapp_snapshot":                                                        "Microsoft.NetApp",
+	"azurerm_netapp_snapshot_policy":                                                 "Microsoft.NetApp",
+	"azurerm_netapp_volume":                                                          "Microsoft.NetApp",
+	"azurerm_netapp_volume_group_sap_hana":                                           "Microsoft.NetApp",
+	"azurerm_netapp_volume_quota_rule":                                               "Microsoft.NetApp",
+	"azurerm_network_connection_monitor":                                             "Microsoft.Network",
+	"azurerm_network_ddos_protection_plan":                                           "Microsoft.Network",
+	"azurerm_network_function_azure_traffic_collector":                               "Microsoft.NetworkFunction",
+	"azurerm_network_function_collector_policy":                                      "Microsoft.NetworkFunction",
+	"azurerm_network_interface":                                                      "Microsoft.Network",
+	"azurerm_network_interface_application_gateway_backend_address_pool_association": "Microsoft.Network",
+	"azurerm_network_interface_application_security_group_association":               "Microsoft.Network",
+	"azurerm_network_interface_backend_address_pool_association":                     "Microsoft.Network",
+	"azurerm_network_interface_nat_rule_association":                                 "Microsoft.Network",
+	"azurerm_network_interface_security_group_association":                           "Microsoft.Network",
+	"azurerm_network_manager":                                                        "Microsoft.Network",
+	"azurerm_network_manager_admin_rule":                                             "Microsoft.Network",
+	"azurerm_network_manager_admin_rule_collection":                                  "Microsoft.Network",
+	"azurerm_network_manager_connectivity_configuration":                             "Microsoft.Network",
+	"azurerm_network_manager_deployment":                                             "Microsoft.Network",
+	"azurerm_network_manager_management_group_connection":                            "Microsoft.Network",
+	"azurerm_network_manager_network_group":                                          "Microsoft.Network",
+	"azurerm_network_manager_scope_connection":                                       "Microsoft.Network",
+	"azurerm_network_manager_security_admin_configuration":                           "Microsoft.Network",
+	"azurerm_network_manager_static_member":                                          "Microsoft.Network",
+	"azurerm_network_manager_subscription_connection":                                "Microsoft.Network",
+	"azurerm_network_packet_capture":                                                 "Microsoft.Network",
+	"azurerm_network_profile":                                                        "Microsoft.Network",
+	"azurerm_network_security_group":                                                 "Microsoft.Network",
+	"azurerm_network_security_rule":                                                  "Microsoft.Network",
+	"azurerm_network_watcher":                                                        "Microsoft.Network",
+	"azurerm_network_watcher_flow_log":                                               "Microsoft.Network",
+	"azurerm_new_relic_monitor":                                                      "NewRelic.Observability",
+	"azurerm_new_relic_tag_rule":                                                     "NewRelic.Observability",
+	"azurerm_nginx_certificate":                                                      "Nginx.NginxPlus",
+	"azurerm_nginx_deployment":                                                       "Nginx.NginxPlus",
+	"azurerm_notification_hub":                                                       "Microsoft.NotificationHubs",
+	"azurerm_notification_hub_authorization_rule":                                    "Microsoft.NotificationHubs",
+	"azurerm_notification_hub_namespace":                                             "Microsoft.NotificationHubs",
+	"azurerm_orbital_contact":                                                        "Microsoft.Orbital",
+	"azurerm_orbital_contact_profile":                                                "Microsoft.Orbital",
+	"azurerm_orbital_spacecraft":                                                     "Microsoft.Orbital",
+	"azurerm_orchestrated_virtual_machine_scale_set":                                 "Microsoft.Compute",
+	"azurerm_palo_alto_local_rulestack":                                              "PaloAltoNetworks.Cloudngfw",
+	"azurerm_palo_alto_local_rulestack_certificate":                                  "PaloAltoNetworks.Cloudngfw",
+	"azurerm_palo_alto_local_rulestack_fqdn_list":                                    "PaloAltoNetworks.Cloudngfw",
+	"azurerm_palo_alto_local_rulestack_prefix_list":                                  "PaloAltoNetworks.Cloudngfw",
+	"azurerm_palo_alto_local_rulestack_rule":                                         "PaloAltoNetworks.Cloudngfw",
+	"azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack":     "PaloAltoNetworks.Cloudngfw",
+	"azurerm_palo_alto_next_generation_firewall_virtual_network_panorama":            "PaloAltoNetworks.Cloudngfw",
+	"azurerm_pim_active_role_assignment":                                             "Microsoft.Authorization",
+	"azurerm_pim_eligible_role_assignment":                                           "Microsoft.Authorization",
+	"azurerm_point_to_site_vpn_gateway":                                              "Microsoft.Network",
+	"azurerm_policy_definition":                                                      "Microsoft.Authorization",
+	"azurerm_policy_set_definition":                                                  "Microsoft.Authorization",
+	"azurerm_policy_virtual_machine_configuration_assignment":                        "Microsoft.GuestConfiguration",
+	"azurerm_portal_dashboard":                                                       "Microsoft.Portal",
+	"azurerm_postgresql_active_directory_administrator":                              "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_configuration":                                               "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_database":                                                    "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_firewall_rule":                                               "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_flexible_server":                                             "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_flexible_server_active_directory_administrator":              "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_flexible_server_configuration":                               "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_flexible_server_database":                                    "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_flexible_server_firewall_rule":                               "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_server":                                                      "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_server_key":                                                  "Microsoft.DBforPostgreSQL",
+	"azurerm_postgresql_virtual_network_rule":                                        "Microsoft.DBforPostgreSQL",
+	"azurerm_powerbi_embedded":                                                       "Microsoft.PowerBIDedicated",
+	"azurerm_private_dns_a_record":                                                   "Microsoft.Network",
+	"azurerm_private_dns_aaaa_record":                                                "Microsoft.Network",
+	"azurerm_private_dns_cname_record":                                               "Microsoft.Network",
+	"azurerm_private_dns_mx_record":                                                  "Microsoft.Network",
+	"azurerm_private_dns_ptr_record":                                                 "Microsoft.Network",
+	"azurerm_private_dns_resolver":                                                   "Microsoft.Network",
+	"azurerm_private_dns_resolver_dns_forwarding_ruleset":                            "Microsoft.Network",
+	"azurerm_private_dns_resolver_forwarding_rule":                                   "Microsoft.Network",
+	"azurerm_private_dns_resolver_inbound_endpoint":                                  "Microsoft.Network",
+	"azurerm_private_dns_resolver_outbound_endpoint":                                 "Microsoft.Network",
+	"azurerm_private_dns_resolver_virtual_network_link":                              "Microsoft.Network",
+	"azurerm_private_dns_srv_record":                                                 "Microsoft.Network",
+	"azurerm_private_dns_txt_record":                                                 "Microsoft.Network",
+	"azurerm_private_dns_zone":                                                       "Microsoft.Network",
+	"azurerm_private_dns_zone_virtual_network_link":                                  "Microsoft.Network",
+	"azurerm_private_endpoint":                                                       "Microsoft.Network",
+	"azurerm_private_endpoint_application_security_group_association":                "Microsoft.Network",
+	"azurerm_private_link_service":                                                   "Microsoft.Network",
+	"azurerm_proximity_placement_group":                                              "Microsoft.Compute",
+	"azurerm_public_ip":                                                              "Microsoft.Network",
+	"azurerm_public_ip_prefix":                                                       "Microsoft.Network",
+	"azurerm_purview_account":                                                        "Microsoft.Purview",
+	"azurerm_recovery_services_vault":                                                "Microsoft.RecoveryServices",
+	"azurerm_recovery_services_vault_resource_guard_association":                     "Microsoft.RecoveryServices",
+	"azurerm_redis_cache":                                                            "Microsoft.Cache",
+	"azurerm_redis_cache_access_policy":                                              "Microsoft.Cache",
+	"azurerm_redis_cache_access_policy_assignment":                                   "Microsoft.Cache",
+	"azurerm_redis_enterprise_cluster":                                               "Microsoft.Cache",
+	"azurerm_redis_enterprise_database":                                              "Microsoft.Cache",
+	"azurerm_redis_firewall_rule":                                                    "Microsoft.Cache",
+	"azurerm_redis_linked_server":                                                    "Microsoft.Cache",
+	"azurerm_relay_hybrid_connection":                                                "Microsoft.Relay",
+	"azurerm_relay_hybrid_connection_authorization_rule":                             "Microsoft.Relay",
+	"azurerm_relay_namespace":                                                        "Microsoft.Relay",
+	"azurerm_relay_namespace_authorization_rule":                                     "Microsoft.Relay",
+	"azurerm_resource_deployment_script_azure_cli":                                   "Microsoft.Resources",
+	"azurerm_resource_deployment_script_azure_power_shell":                           "Microsoft.Resources",
+	"azurerm_resource_group_cost_management_export":                                  "Microsoft.CostManagement",
+	"azurerm_resource_group_cost_management_view":                                    "Microsoft.CostManagement",
+	"azurerm_resource_group_policy_assignment":                                       "Microsoft.Authorization",
+	"azurerm_resource_group_policy_exemption":                                        "Microsoft.Authorization",
+	"azurerm_resource_group_policy_remediation":                                      "Microsoft.PolicyInsights",
+	"azurerm_resource_group_template_deployment":                                     "Microsoft.Resources",
+	"azurerm_resource_management_private_link":                                       "Microsoft.Authorization",
+	"azurerm_resource_management_private_link_association":                           "Microsoft.Authorization",
+	"azurerm_resource_policy_remediation":                                            "Microsoft.PolicyInsights",
+	"azurerm_resource_provider_registration":                                         "Microsoft.PolicyInsights",
+	"azurerm_role_assignment":                                                        "Microsoft.Authorization",
+	"azurerm_role_definition":                                                        "Microsoft.Authorization",
+	"azurerm_route":                                                                  "Microsoft.Network",
+	"azurerm_route_filter":                                                           "Microsoft.Network",
+	"azurerm_route_map":                                                              "Microsoft.Network",
+	"azurerm_route_server":                                                           "Microsoft.Network",
+	"azurerm_route_server_bgp_connection":                                            "Microsoft.Network",
+	"azurerm_route_table":                                                            "Microsoft.Network",
+	"azurerm_search_service":                                                         "Microsoft.Search",
+	"azurerm_search_shared_private_link_service":                                     "Microsoft.Search",
+	"azurerm_security_center_assessment":                                             "Microsoft.Security",
+	"azurerm_security_center_assessment_policy":                                      "Microsoft.Security",
+	"azurerm_security_center_auto_provisioning":                                      "Microsoft.Security",
+	"azurerm_security_center_automation":                                             "Microsoft.Security",
+	"azurerm_security_center_contact":                                                "Microsoft.Security",
+	"azurerm_security_center_server_vulnerability_assessment_virtual_machine":        "Microsoft.Security",
+	"azurerm_security_center_server_vulnerability_assessments_setting":               "Microsoft.Security",
+	"azurerm_security_center_setting":                                                "Microsoft.Security",
+	"azurerm_security_center_storage_defender":                                       "Microsoft.Storage",
+	"azurerm_security_center_subscription_pricing":                                   "Microsoft.Security",
+	"azurerm_security_center_workspace":                                              "Microsoft.Security",
+	"azurerm_sentinel_alert_rule_anomaly_built_in":                                   "Microsoft.SecurityInsights",
+	"azurerm_sentinel_alert_rule_anomaly_duplicate":                                  "Microsoft.SecurityInsights",
+	"azurerm_sentinel_alert_rule_fusion":                                             "Microsoft.SecurityInsights",
+	"azurerm_sentinel_alert_rule_ms_security_incident":                               "Microsoft.SecurityInsights",
+	"azurerm_sentinel_alert_rule_nrt":                                                "Microsoft.SecurityInsights",
+	"azurerm_sentinel_alert_rule_scheduled":                                          "Microsoft.SecurityInsights",
+	"azurerm_sentinel_alert_rule_threat_intelligence":                                "Microsoft.SecurityInsights",
+	"azurerm_sentinel_automation_rule":                                               "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_aws_cloud_trail":                                "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_aws_s3":                                         "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_azure_active_directory":                         "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_azure_advanced_threat_protection":               "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_azure_security_center":                          "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_dynamics_365":                                   "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_iot":                                            "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_microsoft_cloud_app_security":                   "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection":  "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_microsoft_threat_intelligence":                  "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_microsoft_threat_protection":                    "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_office_365":                                     "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_office_365_project":                             "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_office_atp":                                     "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_office_irm":                                     "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_office_power_bi":                                "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_threat_intelligence":                            "Microsoft.SecurityInsights",
+	"azurerm_sentinel_data_connector_threat_intelligence_taxii":                      "Microsoft.SecurityInsights",
+	"azurerm_sentinel_log_analytics_workspace_onboarding":                            "Microsoft.SecurityInsights",
+	"azurerm_sentinel_metadata":                                                      "Microsoft.SecurityInsights",
+	"azurerm_sentinel_threat_intelligence_indicator":                                 "Microsoft.SecurityInsights",
+	"azurerm_sentinel_watchlist":                                                     "Microsoft.SecurityInsights",
+	"azurerm_sentinel_watchlist_item":                                                "Microsoft.SecurityInsights",
+	"azurerm_service_fabric_cluster":                                                 "Microsoft.ServiceFabric",
+	"azurerm_service_fabric_managed_cluster":                                         "Microsoft.ServiceFabric",
+	"azurerm_service_plan":                                                           "Microsoft.Web",
+	"azurerm_servicebus_namespace":                                                   "Microsoft.ServiceBus",
+	"azurerm_servicebus_namespace_authorization_rule":                                "Microsoft.ServiceBus",
+	"azurerm_servicebus_namespace_disaster_recovery_config":                          "Microsoft.ServiceBus",
+	"azurerm_servicebus_namespace_network_rule_set":                                  "Microsoft.ServiceBus",
+	"azurerm_servicebus_queue":                                                       "Microsoft.ServiceBus",
+	"azurerm_servicebus_queue_authorization_rule":                                    "Microsoft.ServiceBus",
+	"azurerm_servicebus_subscription":                                                "Microsoft.ServiceBus",
+	"azurerm_servicebus_subscription_rule":                                           "Microsoft.ServiceBus",
+	"azurerm_servicebus_topic":                                                       "Microsoft.ServiceBus",
+	"azurerm_servicebus_topic_authorization_rule":                                    "Microsoft.ServiceBus",
+	"azurerm_shared_image":                                                           "Microsoft.Compute",
+	"azurerm_shared_image_gallery":                                                   "Microsoft.Compute",
+	"azurerm_shared_image_version":                                                   "Microsoft.Compute",
+	"azurerm_signalr_service":                                                        "Microsoft.SignalRService",
+	"azurerm_signalr_service_custom_certificate":                                     "Microsoft.SignalRService",
+	"azurerm_signalr_service_custom_domain":                                          "Microsoft.SignalRService",
+	"azurerm_signalr_service_network_acl":                                            "Microsoft.SignalRService",
+	"azurerm_site_recovery_fabric":                                                   "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_hyperv_replication_policy":                                "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_network_mapping":                                          "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_protection_container":                                     "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_protection_container_mapping":                             "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_replicated_vm":                                            "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_replication_policy":                                       "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_services_vault_hyperv_site":                               "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_vmware_replicated_vm":                                     "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_vmware_replication_policy":                                "Microsoft.RecoveryServices",
+	"azurerm_site_recovery_vmware_replication_policy_association":                    "Microsoft.RecoveryServices",
+	"azurerm_snapshot":                                                               "Microsoft.Compute",
+	"azurerm_source_control_token":                                                   "Microsoft.Web",
+	"azurerm_spatial_anchors_account":                                                "Microsoft.MixedReality",
+	"azurerm_spring_cloud_accelerator":                                               "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_active_deployment":                                         "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_api_portal":                                                "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_api_portal_custom_domain":                                  "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_app":                                                       "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_app_cosmosdb_association":                                  "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_app_dynamics_application_performance_monitoring":           "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_app_mysql_association":                                     "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_app_redis_association":                                     "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_application_insights_application_performance_monitoring":   "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_application_live_view":                                     "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_build_deployment":                                          "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_build_pack_binding":                                        "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_builder":                                                   "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_certificate":                                               "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_configuration_service":                                     "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_connection":                                                "Microsoft.ServiceLinker",
+	"azurerm_spring_cloud_container_deployment":                                      "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_custom_domain":                                             "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_customized_accelerator":                                    "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_dev_tool_portal":                                           "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_dynatrace_application_performance_monitoring":              "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_elastic_application_performance_monitoring":                "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_gateway":                                                   "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_gateway_custom_domain":                                     "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_gateway_route_config":                                      "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_java_deployment":                                           "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_new_relic_application_performance_monitoring":              "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_service":                                                   "Microsoft.AppPlatform",
+	"azurerm_spring_cloud_storage":                                                   "Microsoft.AppPlatform",
+	"azurerm_sql_active_directory_administrator":                                     "Microsoft.Sql",
+	"azurerm_sql_database":                                                           "Microsoft.Sql",
+	"azurerm_sql_elasticpool":                                                        "Microsoft.Sql",
+	"azurerm_sql_failover_group":                                                     "Microsoft.Sql",
+	"azurerm_sql_firewall_rule":                                                      "Microsoft.Sql",
+	"azurerm_sql_managed_database":                                                   "Microsoft.Sql",
+	"azurerm_sql_managed_instance":                                                   "Microsoft.Sql",
+	"azurerm_sql_managed_instance_active_directory_administrator":                    "Microsoft.Sql",
+	"azurerm_sql_managed_instance_failover_group":                                    "Microsoft.Sql",
+	"azurerm_sql_server":                                                             "Microsoft.Sql",
+	"azurerm_sql_virtual_network_rule":                                               "Microsoft.Sql",
+	"azurerm_ssh_public_key":                                                         "Microsoft.Compute",
+	"azurerm_stack_hci_cluster":                                                      "Microsoft.AzureStackHCI",
+	"azurerm_static_site":                                                            "Microsoft.Web",
+	"azurerm_static_site_custom_domain":                                              "Microsoft.Web",
+	"azurerm_static_web_app":                                                         "Microsoft.Web",
+	"azurerm_static_web_app_custom_domain":                                           "Microsoft.Web",
+	"azurerm_static_web_app_function_app_registration":                               "Microsoft.Web",
+	"azurerm_storage_account":                                                        "Microsoft.Storage",
+	"azurerm_storage_account_customer_managed_key":                                   "Microsoft.Storage",
+	"azurerm_storage_account_local_user":                                             "Microsoft.Storage",
+	"azurerm_storage_account_network_rules":                                          "Microsoft.Storage",
+	"azurerm_storage_blob_inventory_policy":                                          "Microsoft.Storage",
+	"azurerm_storage_container_immutability_policy":                                  "Microsoft.Storage",
+	"azurerm_storage_encryption_scope":                                               "Microsoft.Storage",
+	"azurerm_storage_management_policy":                                              "Microsoft.Storage",
+	"azurerm_storage_mover":                                                          "Microsoft.StorageMover",
+	"azurerm_storage_mover_agent":                                                    "Microsoft.StorageMover",
+	"azurerm_storage_mover_job_definition":                                           "Microsoft.StorageMover",
+	"azurerm_storage_mover_project":                                                  "Microsoft.StorageMover",
+	"azurerm_storage_mover_source_endpoint":                                          "Microsoft.StorageMover",
+	"azurerm_storage_mover_target_endpoint":                                          "Microsoft.StorageMover",
+	"azurerm_storage_object_replication":                                             "Microsoft.Storage",
+	"azurerm_storage_sync":                                                           "Microsoft.StorageSync",
+	"azurerm_storage_sync_cloud_endpoint":                                            "Microsoft.StorageSync",
+	"azurerm_storage_sync_group":                                                     "Microsoft.StorageSync",
+	"azurerm_storage_sync_server_endpoint":                                           "Microsoft.StorageSync",
+	"azurerm_stream_analytics_cluster":                                               "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_function_javascript_uda":                               "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_function_javascript_udf":                               "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_job":                                                   "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_job_schedule":                                          "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_managed_private_endpoint":                              "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_blob":                                           "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_cosmosdb":                                       "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_eventhub":                                       "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_function":                                       "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_mssql":                                          "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_powerbi":                                        "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_servicebus_queue":                               "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_servicebus_topic":                               "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_synapse":                                        "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_output_table":                                          "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_reference_input_blob":                                  "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_reference_input_mssql":                                 "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_stream_input_blob":                                     "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_stream_input_eventhub":                                 "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_stream_input_eventhub_v2":                              "Microsoft.StreamAnalytics",
+	"azurerm_stream_analytics_stream_input_iothub":                                   "Microsoft.StreamAnalytics",
+	"azurerm_subnet":                                                                 "Microsoft.Network",
+	"azurerm_subnet_nat_gateway_association":                                         "Microsoft.Network",
+	"azurerm_subnet_network_security_group_association":                              "Microsoft.Network",
+	"azurerm_subnet_route_table_association":                                         "Microsoft.Network",
+	"azurerm_subnet_service_endpoint_storage_policy":                                 "Microsoft.Network",
+	"azurerm_subscription":                                                           "Microsoft.Subscription",
+	"azurerm_subscription_cost_management_export":                                    "Microsoft.CostManagement",
+	"azurerm_subscription_cost_management_view":                                      "Microsoft.CostManagement",
+	"azurerm_subscription_policy_assignment":                                         "Microsoft.Authorization",
+	"azurerm_subscription_policy_exemption":                                          "Microsoft.Authorization",
+	"azurerm_subscription_policy_remediation":                                        "Microsoft.PolicyInsights",
+	"azurerm_subscription_template_deployment":                                       "Microsoft.Resources",
+	"azurerm_synapse_firewall_rule":                                                  "Microsoft.Synapse",
+	"azurerm_synapse_integration_runtime_azure":                                      "Microsoft.Synapse",
+	"azurerm_synapse_integration_runtime_self_hosted":                                "Microsoft.Synapse",
+	"azurerm_synapse_linked_service":                                                 "Microsoft.Synapse",
+	"azurerm_synapse_managed_private_endpoint":                                       "Microsoft.Synapse",
+	"azurerm_synapse_private_link_hub":                                               "Microsoft.Synapse",
+	"azurerm_synapse_role_assignment":                                                "Microsoft.Synapse",
+	"azurerm_synapse_spark_pool":                                                     "Microsoft.Synapse",
+	"azurerm_synapse_sql_pool":                                                       "Microsoft.Synapse",
+	"azurerm_synapse_sql_pool_extended_auditing_policy":                              "Microsoft.Synapse",
+	"azurerm_synapse_sql_pool_security_alert_policy":                                 "Microsoft.Synapse",
+	"azurerm_synapse_sql_pool_vulnerability_assessment":                              "Microsoft.Synapse",
+	"azurerm_synapse_sql_pool_vulnerability_assessment_baseline":                     "Microsoft.Synapse",
+	"azurerm_synapse_sql_pool_workload_classifier":                                   "Microsoft.Synapse",
+	"azurerm_synapse_sql_pool_workload_group":                                        "Microsoft.Synapse",
+	"azurerm_synapse_workspace":                                                      "Microsoft.Synapse",
+	"azurerm_synapse_workspace_aad_admin":                                            "Microsoft.Synapse",
+	"azurerm_synapse_workspace_extended_auditing_policy":                             "Microsoft.Synapse",
+	"azurerm_synapse_workspace_key":                                                  "Microsoft.Synapse",
+	"azurerm_synapse_workspace_security_alert_policy":                                "Microsoft.Synapse",
+	"azurerm_synapse_workspace_sql_aad_admin":                                        "Microsoft.Synapse",
+	"azurerm_synapse_workspace_vulnerability_assessment":                             "Microsoft.Synapse",
+	"azurerm_system_center_virtual_machine_manager_availability_set":                 "Microsoft.ScVmm",
+	"azurerm_system_center_virtual_machine_manager_server":                           "Microsoft.ScVmm",
+	"azurerm_tenant_template_deployment":                                             "Microsoft.Resources",
+	"azurerm_traffic_manager_profile":                                                "Microsoft.Network",
+	"azurerm_user_assigned_identity":                                                 "Microsoft.ManagedIdentity",
+	"azurerm_video_analyzer":                                                         "Microsoft.Media",
+	"azurerm_video_analyzer_edge_module":                                             "Microsoft.Media",
+	"azurerm_virtual_desktop_application":                                            "Microsoft.DesktopVirtualization",
+	"azurerm_virtual_desktop_application_group":                                      "Microsoft.DesktopVirtualization",
+	"azurerm_virtual_desktop_host_pool":                                              "Microsoft.DesktopVirtualization",
+	"azurerm_virtual_desktop_host_pool_registration_info":                            "Microsoft.DesktopVirtualization",
+	"azurerm_virtual_desktop_scaling_plan":                                           "Microsoft.DesktopVirtualization",
+	"azurerm_virtual_desktop_workspace":                                              "Microsoft.DesktopVirtualization",
+	"azurerm_virtual_desktop_workspace_application_group_association":                "Microsoft.DesktopVirtualization",
+	"azurerm_virtual_hub":                                                            "Microsoft.Network",
+	"azurerm_virtual_hub_bgp_connection":                                             "Microsoft.Network",
+	"azurerm_virtual_hub_connection":                                                 "Microsoft.Network",
+	"azurerm_virtual_hub_ip":                                                         "Microsoft.Network",
+	"azurerm_virtual_hub_route_table":                                                "Microsoft.Network",
+	"azurerm_virtual_hub_route_table_route":                                          "Microsoft.Network",
+	"azurerm_virtual_hub_routing_intent":                                             "Microsoft.Network",
+	"azurerm_virtual_hub_security_partner_provider":                                  "Microsoft.Network",
+	"azurerm_virtual_machine":                                                        "Microsoft.Compute",
+	"azurerm_virtual_machine_automanage_configuration_assignment":                    "Microsoft.AutoManage",
+	"azurerm_virtual_machine_data_disk_attachment":                                   "Microsoft.Compute",
+	"azurerm_virtual_machine_extension":                                              "Microsoft.Compute",
+	"azurerm_virtual_machine_gallery_application_assignment":                         "Microsoft.Compute",
+	"azurerm_virtual_machine_implicit_data_disk_from_source":                         "Microsoft.Compute",
+	"azurerm_virtual_machine_packet_capture":                                         "Microsoft.Network",
+	"azurerm_virtual_machine_run_command":                                            "Microsoft.Compute",
+	"azurerm_virtual_machine_scale_set":                                              "Microsoft.Compute",
+	"azurerm_virtual_machine_scale_set_extension":                                    "Microsoft.Compute",
+	"azurerm_virtual_machine_scale_set_packet_capture":                               "Microsoft.Network",
+	"azurerm_virtual_network":                                                        "Microsoft.Network",
+	"azurerm_virtual_network_dns_servers":                                            "Microsoft.Network",
+	"azurerm_virtual_network_gateway":                                                "Microsoft.Network",
+	"azurerm_virtual_network_gateway_connection":                                     "Microsoft.Network",
+	"azurerm_virtual_network_gateway_nat_rule":                                       "Microsoft.Network",
+	"azurerm_virtual_network_peering":                                                "Microsoft.Network",
+	"azurerm_virtual_wan":                                                            "Microsoft.Network",
+	"azurerm_vmware_cluster":                                                         "Microsoft.AVS",
+	"azurerm_vmware_express_route_authorization":                                     "Microsoft.AVS",
+	"azurerm_vmware_netapp_volume_attachment":                                        "Microsoft.AVS",
+	"azurerm_vmware_private_cloud":                                                   "Microsoft.AVS",
+	"azurerm_voice_services_communications_gateway":                                  "Microsoft.VoiceServices",
+	"azurerm_voice_services_communications_gateway_test_line":                        "Microsoft.VoiceServices",
+	"azurerm_vpn_gateway":                                                            "Microsoft.Network",
+	"azurerm_vpn_gateway_connection":                                                 "Microsoft.Network",
+	"azurerm_vpn_gateway_nat_rule":                                                   "Microsoft.Network",
+	"azurerm_vpn_server_configuration":                                               "Microsoft.Network",
+	"azurerm_vpn_server_configuration_policy_group":                                  "Microsoft.Network",
+	"azurerm_vpn_site":                                                               "Microsoft.Network",
+	"azurerm_web_app_active_slot":                                                    "Microsoft.Web",
+	"azurerm_web_app_hybrid_connection":                                              "Microsoft.Web",
+	"azurerm_web_application_firewall_policy":                                        "Microsoft.Network",
+	"azurerm_web_pubsub":                                                             "Microsoft.SignalRService",
+	"azurerm_web_pubsub_custom_certificate":                                          "Microsoft.SignalRService",
+	"azurerm_web_pubsub_custom_domain":                                               "Microsoft.SignalRService",
+	"azurerm_web_pubsub_hub":                                                         "Microsoft.SignalRService",
+	"azurerm_web_pubsub_network_acl":                                                 "Microsoft.SignalRService",
+	"azurerm_windows_function_app":                                                   "Microsoft.Web",
+	"azurerm_windows_function_app_slot":                                              "Microsoft.Web",
+	"azurerm_windows_virtual_machine":                                                "Microsoft.Compute",
+	"azurerm_windows_virtual_machine_scale_set":                                      "Microsoft.Compute",
+	"azurerm_windows_web_app":                                                        "Microsoft.Web",
+	"azurerm_windows_web_app_slot":                                                   "Microsoft.Web",
+	"azurerm_workloads_sap_discovery_virtual_instance":                               "Microsoft.Workloads",
+	"azurerm_workloads_sap_single_node_virtual_instance":                             "Microsoft.Workloads",
+	"azurerm_workloads_sap_three_tier_virtual_instance":                              "Microsoft.Workloads",
+}
@@ -20,14 +20,15 @@ var enhancedEnabled = features.EnhancedValidationEnabled()
 // NOTE: this is best-effort - if the users offline, or the API doesn't return it we'll
 // fall back to the original approach
 func EnhancedValidate(i interface{}, k string) ([]string, []error) {
-	if !enhancedEnabled || cachedResourceProviders == nil {
+	supported := supportedResourceProviders()
+	if !enhancedEnabled || supported == nil {
 		return validation.StringIsNotEmpty(i, k)
 	}
 
-	return enhancedValidation(i, k)
+	return enhancedValidation(i, k, supported)
 }
 
-func enhancedValidation(i interface{}, k string) ([]string, []error) {
+func enhancedValidation(i interface{}, k string, supported []string) ([]string, []error) {
 	v, ok := i.(string)
 	if !ok {
 		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
@@ -37,20 +38,15 @@ func enhancedValidation(i interface{}, k string) ([]string, []error) {
 		return nil, []error{fmt.Errorf("%q must not be empty", k)}
 	}
 
-	// enhanced validation is unavailable, but we're in this method..
-	if cachedResourceProviders == nil {
-		return nil, nil
-	}
-
 	found := false
-	for _, provider := range *cachedResourceProviders {
+	for _, provider := range supported {
 		if provider == v {
 			found = true
 		}
 	}
 
 	if !found {
-		providersJoined := strings.Join(*cachedResourceProviders, ", ")
+		providersJoined := strings.Join(supported, ", ")
 		return nil, []error{
 			fmt.Errorf("%q was not found in the list of supported Resource Providers: %q", v, providersJoined),
 		}
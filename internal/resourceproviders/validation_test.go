@@ -34,7 +34,7 @@ func TestEnhancedValidationDisabled(t *testing.T) {
 	enhancedEnabled = false
 	defer func() {
 		enhancedEnabled = features.EnhancedValidationEnabled()
-		cachedResourceProviders = nil
+		ClearCache()
 	}()
 
 	for _, testCase := range testCases {
@@ -71,10 +71,10 @@ func TestEnhancedValidationEnabled(t *testing.T) {
 		},
 	}
 	enhancedEnabled = true
-	cachedResourceProviders = &[]string{"Microsoft.Compute"}
+	cachedSubscriptions["00000000-0000-0000-0000-000000000000"] = newCachedResourceProviders([]string{"Microsoft.Compute"}, nil)
 	defer func() {
 		enhancedEnabled = features.EnhancedValidationEnabled()
-		cachedResourceProviders = nil
+		ClearCache()
 	}()
 
 	for _, testCase := range testCases {
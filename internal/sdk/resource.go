@@ -27,9 +27,9 @@ type resourceWithPluginSdkSchema interface {
 
 type resourceBase interface {
 	// resourceWithPluginSdkSchema ensure that the Arguments and Attributes are sourced
-	// from Plugin SDKv2 for now - longer term we'll likely introduce a `Typed Schema`
-	// which will cross-compile down to both the Plugin SDKv2 and Plugin Framework, but
-	// that's a story for another day.
+	// from Plugin SDKv2 for now - these can be rendered from a TypedSchema, however
+	// until the Plugin Framework is available to the Provider, that's only able to
+	// render into Plugin SDKv2.
 	resourceWithPluginSdkSchema
 
 	// ModelObject is an instance of the object the Schema is decoded/encoded into
@@ -99,7 +99,7 @@ type StateUpgradeData struct {
 	Upgraders     map[int]pluginsdk.StateUpgrade
 }
 
-// TODO: a generic state migration for updating ID's
+// NOTE: ResourceIDStateUpgrade can be used for State Upgrades which only need to update the format of Resource ID's
 
 type ResourceWithCustomImporter interface {
 	Resource
@@ -171,6 +171,10 @@ type ResourceFunc struct {
 	// Timeout is the default timeout, which can be overridden by users
 	// for this method - in-turn used for the Azure API
 	Timeout time.Duration
+
+	// ConsistencyCheck optionally polls the Read function after a Create or Update function
+	// until the values returned match those which were planned, for eventually consistent APIs
+	ConsistencyCheck *ConsistencyCheck
 }
 
 type ResourceMetaData struct {
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ConsistencyCheck configures waiting for an eventually consistent API to return the planned values once a
+// Create or Update has completed - by polling the Read function until the values it returns match those in
+// the plan, rather than storing stale values (which causes a `Provider produced inconsistent result` error).
+//
+// Typed Resources specify this on the Create/Update ResourceFunc, Untyped Resources call ReadUntilConsistent.
+type ConsistencyCheck struct {
+	// Fields optionally limits the comparison to these top-level fields, by default each of the Arguments
+	// which is explicitly configured is compared (this must be specified when calling ReadUntilConsistent)
+	Fields []string
+
+	// IsPending optionally determines whether an error returned from Read means that the resource hasn't
+	// propagated yet (for example a 403 until a newly created resource is available), in which case Read
+	// is polled again - by default any error returned from Read is returned
+	IsPending func(err error) bool
+
+	// PollInterval is the duration between each Read, defaults to 5 seconds
+	PollInterval time.Duration
+
+	// ContinuousTargetOccurence is the number of consecutive Reads which must return the planned values,
+	// defaults to 1
+	ContinuousTargetOccurence int
+
+	// Timeout is the maximum duration to wait for the planned values to be returned, defaults to the time
+	// remaining for the Create/Update
+	Timeout time.Duration
+}
+
+const (
+	consistencyStateConsistent   = "Consistent"
+	consistencyStateInconsistent = "Inconsistent"
+)
+
+// readUntilConsistent calls the Read function of the Resource until the values it returns match those
+// which were planned, as configured in the ConsistencyCheck
+func (rw *ResourceWrapper) readUntilConsistent(ctx context.Context, metaData ResourceMetaData, check ConsistencyCheck) error {
+	if len(check.Fields) == 0 {
+		resourceSchema, err := combineSchema(rw.resource.Arguments(), rw.resource.Attributes())
+		if err != nil {
+			return fmt.Errorf("building Schema: %+v", err)
+		}
+		for k, v := range *resourceSchema {
+			if v.Required || v.Optional {
+				check.Fields = append(check.Fields, k)
+			}
+		}
+	}
+
+	return ReadUntilConsistent(ctx, metaData.ResourceData, check, func() error {
+		return rw.resource.Read().Func(ctx, metaData)
+	})
+}
+
+// ReadUntilConsistent calls read (the Read function of the resource) until the values it sets match those
+// which were planned for the specified Fields, as configured in the ConsistencyCheck
+func ReadUntilConsistent(ctx context.Context, d *schema.ResourceData, check ConsistencyCheck, read func() error) error {
+	if len(check.Fields) == 0 {
+		return fmt.Errorf("internal-error: the fields to compare must be specified to wait for %s to be consistent", d.Id())
+	}
+
+	planned := make(map[string]interface{})
+	for _, field := range check.Fields {
+		// fields which aren't configured can be defaulted by the API, so aren't expected to match
+		if pluginsdk.IsExplicitlyConfigured(d, field) {
+			planned[field] = normalizeForComparison(d.Get(field))
+		}
+	}
+
+	id := d.Id()
+	pollInterval := check.PollInterval
+	if pollInterval == 0 {
+		pollInterval = 5 * time.Second
+	}
+	timeout := check.Timeout
+	if deadline, ok := ctx.Deadline(); ok && (timeout == 0 || time.Until(deadline) < timeout) {
+		timeout = time.Until(deadline)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("internal-error: a timeout must be specified to wait for %s to be consistent", id)
+	}
+
+	var inconsistent []string
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{consistencyStateInconsistent},
+		Target:  []string{consistencyStateConsistent},
+		Refresh: func() (interface{}, string, error) {
+			if err := read(); err != nil {
+				if check.IsPending != nil && check.IsPending(err) {
+					inconsistent = []string{"id"}
+					return inconsistent, consistencyStateInconsistent, nil
+				}
+				return nil, "", err
+			}
+
+			// the resource may not be returned immediately after it's been created, in which case the
+			// Read function will have removed it from the state
+			if d.Id() == "" {
+				d.SetId(id)
+				inconsistent = []string{"id"}
+				return inconsistent, consistencyStateInconsistent, nil
+			}
+
+			inconsistent = inconsistentFields(d, planned)
+			if len(inconsistent) > 0 {
+				return inconsistent, consistencyStateInconsistent, nil
+			}
+			return inconsistent, consistencyStateConsistent, nil
+		},
+		PollInterval:              pollInterval,
+		ContinuousTargetOccurence: check.ContinuousTargetOccurence,
+		Timeout:                   timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if len(inconsistent) > 0 {
+			return fmt.Errorf("waiting for %s to return the planned values for %s: %+v", id, strings.Join(inconsistent, ", "), err)
+		}
+		return fmt.Errorf("waiting for %s to return the planned values: %+v", id, err)
+	}
+
+	return nil
+}
+
+// inconsistentFields returns the (sorted) names of the fields whose current value differs from the planned value
+func inconsistentFields(d *schema.ResourceData, planned map[string]interface{}) []string {
+	output := make([]string, 0)
+	for field, plannedValue := range planned {
+		if !reflect.DeepEqual(plannedValue, normalizeForComparison(d.Get(field))) {
+			output = append(output, field)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+// normalizeForComparison converts any Sets within the value into Lists, since the items within a Set are
+// ordered by their hash (and so are comparable) but the Set itself contains its hash function
+func normalizeForComparison(input interface{}) interface{} {
+	switch v := input.(type) {
+	case *schema.Set:
+		return normalizeForComparison(v.List())
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			output = append(output, normalizeForComparison(item))
+		}
+		return output
+
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			output[key] = normalizeForComparison(item)
+		}
+		return output
+	}
+
+	return input
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestInconsistentFields(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ip_rules": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"network_rules": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"default_action": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"subnet_ids": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+	planned := map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"environment": "test",
+		},
+		"ip_rules": []interface{}{"10.0.0.1", "10.0.0.2"},
+		"network_rules": []interface{}{
+			map[string]interface{}{
+				"default_action": "Deny",
+				"subnet_ids":     []interface{}{"subnet1", "subnet2"},
+			},
+		},
+	}
+	expected := schema.TestResourceDataRaw(t, resourceSchema, planned)
+	for k := range planned {
+		planned[k] = normalizeForComparison(expected.Get(k))
+	}
+
+	testData := []struct {
+		Name     string
+		Current  map[string]interface{}
+		Expected []string
+	}{
+		{
+			Name: "Consistent",
+			Current: map[string]interface{}{
+				"name": "example",
+				"tags": map[string]interface{}{
+					"environment": "test",
+				},
+				"ip_rules": []interface{}{"10.0.0.2", "10.0.0.1"},
+				"network_rules": []interface{}{
+					map[string]interface{}{
+						"default_action": "Deny",
+						"subnet_ids":     []interface{}{"subnet2", "subnet1"},
+					},
+				},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Stale",
+			Current: map[string]interface{}{
+				"name":     "example",
+				"tags":     map[string]interface{}{},
+				"ip_rules": []interface{}{"10.0.0.1"},
+				"network_rules": []interface{}{
+					map[string]interface{}{
+						"default_action": "Deny",
+						"subnet_ids":     []interface{}{"subnet1"},
+					},
+				},
+			},
+			Expected: []string{"ip_rules", "network_rules", "tags"},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		d := schema.TestResourceDataRaw(t, resourceSchema, testCase.Current)
+		actual := inconsistentFields(d, planned)
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Fatalf("expected %+v but got %+v", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestReadUntilConsistentPending(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"name": "example",
+	})
+	d.SetId("example")
+
+	errPending := errors.New("pending")
+	reads := 0
+	read := func() error {
+		reads++
+		switch reads {
+		case 1:
+			return errPending
+		case 2:
+			// the resource isn't returned yet, so is removed from the state
+			d.SetId("")
+		}
+		return nil
+	}
+
+	check := ConsistencyCheck{
+		Fields: []string{"name"},
+		IsPending: func(err error) bool {
+			return errors.Is(err, errPending)
+		},
+		PollInterval: time.Millisecond,
+		Timeout:      time.Minute,
+	}
+	if err := ReadUntilConsistent(context.Background(), d, check, read); err != nil {
+		t.Fatalf("waiting for the resource to be consistent: %+v", err)
+	}
+	if reads != 3 {
+		t.Fatalf("expected 3 reads but got %d", reads)
+	}
+	if d.Id() != "example" {
+		t.Fatalf("expected the ID to be retained but got %q", d.Id())
+	}
+
+	check.IsPending = nil
+	reads = 0
+	if err := ReadUntilConsistent(context.Background(), d, check, read); err == nil {
+		t.Fatalf("expected an error when Read fails and the error isn't pending")
+	}
+}
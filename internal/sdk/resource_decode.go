@@ -6,8 +6,10 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // Decode will decode the Terraform Schema into the specified object
@@ -22,13 +24,41 @@ import (
 //
 // var person Person
 // if err := metadata.Decode(&person); err != nil { .. }
+//
+// Fields using the `explicitlyConfigured` struct tag option are only decoded when they're explicitly
+// configured (see IsExplicitlyConfigured) - and so should be a pointer, slice or map, which is left as
+// nil when the field is omitted from the configuration. This allows building PATCH payloads which only
+// contain the fields the user has specified, rather than sending the zero value for omitted fields:
+//
+//	type Account struct {
+//		 Enabled *bool `tfschema:"enabled,explicitlyConfigured"`
+//	}
+//
+// NOTE: since the configuration is only available during Create and Update, these fields are decoded
+// from the state as usual when Decode is called from a Read.
 func (rmd ResourceMetaData) Decode(input interface{}) error {
 	if rmd.ResourceData == nil {
 		return fmt.Errorf("ResourceData was nil")
 	}
+	if !rmd.ResourceData.GetRawConfig().IsNull() {
+		return decodeReflectedType(input, resourceDataWithConfig{rmd.ResourceData}, rmd.serializationDebugLogger)
+	}
 	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
 }
 
+// IsExplicitlyConfigured returns whether the field at the specified key (for example `enabled` or
+// `network_rules.0.default_action`) has been given a value in the configuration - which is true for a
+// field set to its zero value (e.g. `false`, `0` or `""`) but false when it's omitted or set to `null`.
+//
+// This is intended for use in Create and Update functions, since the configuration isn't available
+// (and so this always returns false) in other functions.
+func (rmd ResourceMetaData) IsExplicitlyConfigured(key string) bool {
+	if rmd.ResourceData == nil {
+		return false
+	}
+	return pluginsdk.IsExplicitlyConfigured(rmd.ResourceData, key)
+}
+
 // DecodeDiff decodes the Terraform Schema into the specified object in the
 // same manner as Decode, but using the ResourceDiff as a source. Intended
 // for use in CustomizeDiff functions.
@@ -46,6 +76,20 @@ type stateRetriever interface {
 	GetOkExists(key string) (interface{}, bool)
 }
 
+// configRetriever is implemented by a stateRetriever which has access to the configuration, allowing
+// fields using the `explicitlyConfigured` struct tag option to be skipped when they're not configured
+type configRetriever interface {
+	IsExplicitlyConfigured(key string) bool
+}
+
+type resourceDataWithConfig struct {
+	*schema.ResourceData
+}
+
+func (d resourceDataWithConfig) IsExplicitlyConfigured(key string) bool {
+	return pluginsdk.IsExplicitlyConfigured(d.ResourceData, key)
+}
+
 func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) error {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
@@ -62,6 +106,11 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 		}
 
 		if structTags != nil {
+			if config, ok := stateRetriever.(configRetriever); ok && structTags.explicitlyConfigured && !config.IsExplicitlyConfigured(structTags.hclPath) {
+				debugLogger.Infof("%q isn't explicitly configured - skipping", structTags.hclPath)
+				continue
+			}
+
 			tfschemaValue, valExists := stateRetriever.GetOkExists(structTags.hclPath)
 			if !valExists {
 				continue
@@ -92,6 +141,10 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		}
 	}()
 
+	if field := reflect.ValueOf(input).Elem().Field(index); isTimeType(field.Type()) {
+		return setTimeValue(field, tfschemaValue, fieldName)
+	}
+
 	if v, ok := tfschemaValue.(string); ok {
 		n := reflect.ValueOf(input).Elem().Field(index)
 		if n.Kind() == reflect.Pointer {
@@ -113,7 +166,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		if n.Kind() == reflect.Pointer {
 			debugLogger.Infof("*[INT] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
-			tmp.Elem().Set(reflect.ValueOf(v))
+			tmp.Elem().Set(reflect.ValueOf(v).Convert(n.Type().Elem()))
 			n.Set(tmp)
 		} else {
 			debugLogger.Infof("[INT] Decode %+v", v)
@@ -127,7 +180,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		if n.Kind() == reflect.Pointer {
 			debugLogger.Infof("*[INT] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
-			tmp.Elem().Set(reflect.ValueOf(v))
+			tmp.Elem().Set(reflect.ValueOf(v).Convert(n.Type().Elem()))
 			n.Set(tmp)
 		} else {
 			debugLogger.Infof("[INT] Decode %+v", v)
@@ -141,7 +194,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		if n.Kind() == reflect.Pointer {
 			debugLogger.Infof("*[INT] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
-			tmp.Elem().Set(reflect.ValueOf(v))
+			tmp.Elem().Set(reflect.ValueOf(v).Convert(n.Type().Elem()))
 			n.Set(tmp)
 		} else {
 			debugLogger.Infof("[INT] Decode %+v", v)
@@ -155,7 +208,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		if n.Kind() == reflect.Pointer {
 			debugLogger.Infof("*[Float] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
-			tmp.Elem().Set(reflect.ValueOf(v))
+			tmp.Elem().Set(reflect.ValueOf(v).Convert(n.Type().Elem()))
 			n.Set(tmp)
 		} else {
 			debugLogger.Infof("[Float] Decode %+v", v)
@@ -190,7 +243,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 
 			mapOutput := reflect.MakeMap(ty.Type())
 			for key, val := range mapConfig {
-				mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+				mapOutput.SetMapIndex(reflect.ValueOf(key), mapValueOf(val, mapOutput.Type().Elem()))
 			}
 
 			tmp.Elem().Set(mapOutput)
@@ -199,7 +252,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		} else {
 			mapOutput := reflect.MakeMap(n.Type())
 			for key, val := range mapConfig {
-				mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+				mapOutput.SetMapIndex(reflect.ValueOf(key), mapValueOf(val, mapOutput.Type().Elem()))
 			}
 
 			reflect.ValueOf(input).Elem().Field(index).Set(mapOutput)
@@ -215,7 +268,7 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 
 		mapOutput := reflect.MakeMap(ty.Type())
 		for key, val := range *mapConfig {
-			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+			mapOutput.SetMapIndex(reflect.ValueOf(key), mapValueOf(val, mapOutput.Type().Elem()))
 		}
 
 		tmp.Elem().Set(mapOutput)
@@ -234,6 +287,15 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 
 func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
 	fieldType := reflect.ValueOf(input).Elem().Field(index).Type()
+
+	// a block containing a single item can be decoded into a struct, or a pointer to one when it's optional
+	if objType, ok := nestedObjectType(fieldType); ok {
+		return setNestedObjectValue(reflect.ValueOf(input).Elem().Field(index), objType, fieldName, v, debugLogger)
+	}
+	if objType, ok := sliceOfPointersType(fieldType); ok {
+		return setSliceOfPointersValue(reflect.ValueOf(input).Elem().Field(index), objType, fieldName, v, debugLogger)
+	}
+
 	fieldTypeStr := fieldType.String()
 	switch fieldTypeStr {
 	case "[]string":
@@ -389,3 +451,157 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 
 	return nil
 }
+
+// isTimeType returns whether the specified type is a time.Time, or a pointer to one
+func isTimeType(input reflect.Type) bool {
+	if input.Kind() == reflect.Pointer {
+		input = input.Elem()
+	}
+	return input == reflect.TypeOf(time.Time{})
+}
+
+// setTimeValue parses the RFC3339 formatted value into the time.Time (or *time.Time) field - since
+// an empty string means the value hasn't been set, the field is left as-is in that case
+func setTimeValue(field reflect.Value, tfschemaValue interface{}, fieldName string) error {
+	v, ok := tfschemaValue.(string)
+	if !ok || v == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return fmt.Errorf("parsing %q as an RFC3339 timestamp for %q: %+v", v, fieldName, err)
+	}
+
+	if field.Kind() == reflect.Pointer {
+		field.Set(reflect.ValueOf(&t))
+	} else {
+		field.Set(reflect.ValueOf(t))
+	}
+	return nil
+}
+
+// mapValueOf returns the value of an item within a map, converted to the type used for the values within the
+// model's map - including any nested maps
+func mapValueOf(val interface{}, elemType reflect.Type) reflect.Value {
+	if val == nil {
+		return reflect.Zero(elemType)
+	}
+
+	if nested, ok := val.(map[string]interface{}); ok && elemType.Kind() == reflect.Map {
+		output := reflect.MakeMap(elemType)
+		for k, v := range nested {
+			output.SetMapIndex(reflect.ValueOf(k), mapValueOf(v, elemType.Elem()))
+		}
+		return output
+	}
+
+	rv := reflect.ValueOf(val)
+	if !rv.Type().AssignableTo(elemType) && isNumericKind(rv.Kind()) && isNumericKind(elemType.Kind()) {
+		return rv.Convert(elemType)
+	}
+	return rv
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// nestedObjectType returns the struct type when the field is a (non-time) struct, or a pointer to one
+func nestedObjectType(fieldType reflect.Type) (reflect.Type, bool) {
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	return fieldType, fieldType.Kind() == reflect.Struct && !isTimeType(fieldType)
+}
+
+// sliceOfPointersType returns the struct type when the field is a slice of pointers to structs (e.g. `[]*Item`
+// or `*[]*Item`)
+func sliceOfPointersType(fieldType reflect.Type) (reflect.Type, bool) {
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() != reflect.Slice || fieldType.Elem().Kind() != reflect.Pointer {
+		return nil, false
+	}
+	return nestedObjectType(fieldType.Elem())
+}
+
+// decodeNestedObject decodes an item from a nested block into a new instance of the specified struct type,
+// returning a pointer to it
+func decodeNestedObject(objType reflect.Type, value map[string]interface{}, fieldName string, debugLogger Logger) (reflect.Value, error) {
+	elem := reflect.New(objType)
+	for j := 0; j < objType.NumField(); j++ {
+		nestedField := objType.Field(j)
+
+		structTags, err := parseStructTags(nestedField.Tag)
+		if err != nil {
+			return elem, fmt.Errorf("parsing struct tags for nested field %q: %+v", nestedField.Name, err)
+		}
+
+		if structTags != nil {
+			if err := setValue(elem.Interface(), value[structTags.hclPath], j, fieldName, debugLogger); err != nil {
+				return elem, err
+			}
+		}
+	}
+
+	return elem, nil
+}
+
+func setNestedObjectValue(field reflect.Value, objType reflect.Type, fieldName string, v []interface{}, debugLogger Logger) error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	value, ok := v[0].(map[string]interface{})
+	if !ok || value == nil {
+		return nil
+	}
+
+	elem, err := decodeNestedObject(objType, value, fieldName, debugLogger)
+	if err != nil {
+		return err
+	}
+
+	if field.Kind() == reflect.Pointer {
+		field.Set(elem)
+	} else {
+		field.Set(elem.Elem())
+	}
+	return nil
+}
+
+func setSliceOfPointersValue(field reflect.Value, objType reflect.Type, fieldName string, v []interface{}, debugLogger Logger) error {
+	sliceType := field.Type()
+	if sliceType.Kind() == reflect.Pointer {
+		sliceType = sliceType.Elem()
+	}
+
+	output := reflect.MakeSlice(sliceType, 0, len(v))
+	for _, item := range v {
+		value, ok := item.(map[string]interface{})
+		if !ok || value == nil {
+			continue
+		}
+
+		elem, err := decodeNestedObject(objType, value, fieldName, debugLogger)
+		if err != nil {
+			return err
+		}
+		output = reflect.Append(output, elem)
+	}
+
+	if field.Kind() == reflect.Pointer {
+		tmp := reflect.New(sliceType)
+		tmp.Elem().Set(output)
+		field.Set(tmp)
+	} else {
+		field.Set(output)
+	}
+	return nil
+}
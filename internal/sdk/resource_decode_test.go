@@ -6,8 +6,10 @@ package sdk
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type decodeTestData struct {
@@ -1026,6 +1028,275 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleOptionalItems(t *testing.T)
 	}.test(t)
 }
 
+func TestResourceDecode_Pointers(t *testing.T) {
+	type SimpleType struct {
+		Int32   *int32   `tfschema:"int32"`
+		Int64   *int64   `tfschema:"int64"`
+		Float32 *float32 `tfschema:"float32"`
+		Zero    *int64   `tfschema:"zero"`
+		Omitted *int64   `tfschema:"omitted"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"int32":   42,
+			"int64":   4294967296,
+			"float32": float64(1.5),
+			"zero":    0,
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			Int32:   pointer.To(int32(42)),
+			Int64:   pointer.To(int64(4294967296)),
+			Float32: pointer.To(float32(1.5)),
+			Zero:    pointer.To(int64(0)),
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_NestedMaps(t *testing.T) {
+	type SimpleType struct {
+		MapOfInt64s     map[string]int64                   `tfschema:"map_of_int64s"`
+		MapOfInterfaces map[string]interface{}             `tfschema:"map_of_interfaces"`
+		NestedMap       map[string]map[string]string       `tfschema:"nested_map"`
+		NestedMapPtr    *map[string]map[string]interface{} `tfschema:"nested_map_ptr"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"map_of_int64s": map[string]interface{}{
+				"hello": 1,
+			},
+			"map_of_interfaces": map[string]interface{}{
+				"hello": "world",
+				"count": 2,
+			},
+			"nested_map": map[string]interface{}{
+				"outer": map[string]interface{}{
+					"inner": "value",
+				},
+			},
+			"nested_map_ptr": map[string]interface{}{
+				"outer": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			MapOfInt64s: map[string]int64{
+				"hello": 1,
+			},
+			MapOfInterfaces: map[string]interface{}{
+				"hello": "world",
+				"count": 2,
+			},
+			NestedMap: map[string]map[string]string{
+				"outer": {
+					"inner": "value",
+				},
+			},
+			NestedMapPtr: &map[string]map[string]interface{}{
+				"outer": {
+					"enabled": true,
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_SetOfStructs(t *testing.T) {
+	type Inner struct {
+		Name  string `tfschema:"name"`
+		Value *int   `tfschema:"value"`
+	}
+	type Type struct {
+		Items       []Inner  `tfschema:"items"`
+		PointerSet  []*Inner `tfschema:"pointer_set"`
+		OptionalSet *[]Inner `tfschema:"optional_set"`
+	}
+	hash := func(v interface{}) int {
+		return len(v.(map[string]interface{})["name"].(string))
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"items": schema.NewSet(hash, []interface{}{
+				map[string]interface{}{
+					"name":  "a",
+					"value": 1,
+				},
+				map[string]interface{}{
+					"name": "bb",
+				},
+			}),
+			"pointer_set": schema.NewSet(hash, []interface{}{
+				map[string]interface{}{
+					"name":  "a",
+					"value": 2,
+				},
+			}),
+			"optional_set": schema.NewSet(hash, []interface{}{}),
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Items: []Inner{
+				{
+					Name:  "a",
+					Value: pointer.To(1),
+				},
+				{
+					Name: "bb",
+				},
+			},
+			PointerSet: []*Inner{
+				{
+					Name:  "a",
+					Value: pointer.To(2),
+				},
+			},
+			OptionalSet: &[]Inner{},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_SingleNestedObject(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Required Inner  `tfschema:"required"`
+		Optional *Inner `tfschema:"optional"`
+		Empty    *Inner `tfschema:"empty"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"required": []interface{}{
+				map[string]interface{}{
+					"value": "required",
+				},
+			},
+			"optional": []interface{}{
+				map[string]interface{}{
+					"value": "optional",
+				},
+			},
+			"empty": []interface{}{},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Required: Inner{
+				Value: "required",
+			},
+			Optional: &Inner{
+				Value: "optional",
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_Time(t *testing.T) {
+	type Inner struct {
+		Expires time.Time `tfschema:"expires"`
+	}
+	type Type struct {
+		Created  time.Time  `tfschema:"created"`
+		Modified *time.Time `tfschema:"modified"`
+		Deleted  *time.Time `tfschema:"deleted"`
+		Nested   []Inner    `tfschema:"nested"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"created":  "2024-01-02T03:04:05Z",
+			"modified": "2024-01-02T03:04:05+01:00",
+			"deleted":  "",
+			"nested": []interface{}{
+				map[string]interface{}{
+					"expires": "2025-06-07T08:09:10Z",
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Created:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Modified: pointer.To(time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("", 3600))),
+			Nested: []Inner{
+				{
+					Expires: time.Date(2025, 6, 7, 8, 9, 10, 0, time.UTC),
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_TimeInvalid(t *testing.T) {
+	type Type struct {
+		Created time.Time `tfschema:"created"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"created": "yesterday",
+		},
+		Input:       &Type{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceDecode_ExplicitlyConfigured(t *testing.T) {
+	type Type struct {
+		Name            string            `tfschema:"name"`
+		Enabled         *bool             `tfschema:"enabled,explicitlyConfigured"`
+		Count           *int64            `tfschema:"count,explicitlyConfigured"`
+		Tags            map[string]string `tfschema:"tags,explicitlyConfigured"`
+		OmittedFromSet  *bool             `tfschema:"omitted_from_set,explicitlyConfigured"`
+		OmittedDecoded  *bool             `tfschema:"omitted_decoded"`
+		ComputedDefault *string           `tfschema:"computed_default,explicitlyConfigured"`
+	}
+	state := map[string]interface{}{
+		"name":             "example",
+		"enabled":          false,
+		"count":            0,
+		"tags":             map[string]interface{}{},
+		"omitted_from_set": false,
+		"omitted_decoded":  false,
+		"computed_default": "Standard",
+	}
+	configured := []string{"name", "enabled", "count", "tags"}
+
+	input := &Type{}
+	expected := &Type{
+		Name:           "example",
+		Enabled:        pointer.To(false),
+		Count:          pointer.To(int64(0)),
+		Tags:           map[string]string{},
+		OmittedDecoded: pointer.To(false),
+	}
+	getter := testDataGetterWithConfig{
+		testDataGetter: testDataGetter{
+			values: state,
+		},
+		configured: configured,
+	}
+	if err := decodeReflectedType(input, getter, ConsoleLogger{}); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(input, expected) {
+		t.Fatalf("\nExpected: %+v\n\n Received %+v\n\n", expected, input)
+	}
+
+	// when the configuration isn't available (e.g. during a Read) these are decoded from the state as usual
+	decodeTestData{
+		State: state,
+		Input: &Type{},
+		Expected: &Type{
+			Name:            "example",
+			Enabled:         pointer.To(false),
+			Count:           pointer.To(int64(0)),
+			Tags:            map[string]string{},
+			OmittedFromSet:  pointer.To(false),
+			OmittedDecoded:  pointer.To(false),
+			ComputedDefault: pointer.To("Standard"),
+		},
+	}.test(t)
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
@@ -1070,3 +1341,17 @@ func (td testDataGetter) GetOkExists(key string) (interface{}, bool) {
 	val, ok := td.values[key]
 	return val, ok
 }
+
+type testDataGetterWithConfig struct {
+	testDataGetter
+	configured []string
+}
+
+func (td testDataGetterWithConfig) IsExplicitlyConfigured(key string) bool {
+	for _, v := range td.configured {
+		if v == key {
+			return true
+		}
+	}
+	return false
+}
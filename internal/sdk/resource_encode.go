@@ -6,6 +6,7 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -101,7 +102,6 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 
 			case reflect.Slice:
 				sv := fieldVal.Slice(0, fieldVal.Len())
-				attr := make([]interface{}, sv.Len())
 				switch sv.Type() {
 				case reflect.TypeOf([]string{}):
 					debugLogger.Infof("Setting %q to []string", structTags.hclPath)
@@ -136,22 +136,22 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 					}
 
 				default:
-					for i := 0; i < sv.Len(); i++ {
-						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-						nestedType := sv.Index(i).Type()
-						nestedValue := sv.Index(i)
-
-						serialized, err := recurse(nestedType, nestedValue, debugLogger)
-						if err != nil {
-							return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
-						}
-						attr[i] = serialized
+					attr, err := encodeNestedObjects(sv, debugLogger)
+					if err != nil {
+						return nil, err
 					}
 					debugLogger.Infof("[SLICE] Setting %q to %+v", structTags.hclPath, attr)
 					output[structTags.hclPath] = attr
 				}
 
+			case reflect.Struct:
+				v, err := encodeStructValue(fieldVal, debugLogger)
+				if err != nil {
+					return nil, err
+				}
+				debugLogger.Infof("Setting %q to %+v", structTags.hclPath, v)
+				output[structTags.hclPath] = v
+
 			case reflect.Pointer:
 				if !fieldVal.IsNil() {
 					pv := fieldVal.Elem()
@@ -186,7 +186,6 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 
 					case reflect.Slice:
 						sv := pv.Slice(0, pv.Len())
-						attr := make([]interface{}, sv.Len())
 						switch sv.Type() {
 						case reflect.TypeOf([]string{}):
 							debugLogger.Infof("Setting %q to []string", structTags.hclPath)
@@ -221,22 +220,22 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 							}
 
 						default:
-							for i := 0; i < sv.Len(); i++ {
-								debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-								debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-								nestedType := sv.Index(i).Type()
-								nestedValue := sv.Index(i)
-
-								serialized, err := recurse(nestedType, nestedValue, debugLogger)
-								if err != nil {
-									return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
-								}
-								attr[i] = serialized
+							attr, err := encodeNestedObjects(sv, debugLogger)
+							if err != nil {
+								return nil, err
 							}
 							debugLogger.Infof("[SLICE] Setting %q to %+v", structTags.hclPath, attr)
 							output[structTags.hclPath] = attr
 						}
 
+					case reflect.Struct:
+						v, err := encodeStructValue(pv, debugLogger)
+						if err != nil {
+							return nil, err
+						}
+						debugLogger.Infof("Setting %q to %+v", structTags.hclPath, v)
+						output[structTags.hclPath] = v
+
 					}
 				} else {
 					debugLogger.Infof("Setting %q to nil", structTags.hclPath)
@@ -251,3 +250,44 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 
 	return output, nil
 }
+
+// encodeStructValue encodes a time.Time as an RFC3339 formatted string (or an empty string when it's not set),
+// and any other struct as a block containing a single item
+func encodeStructValue(input reflect.Value, debugLogger Logger) (interface{}, error) {
+	if v, ok := input.Interface().(time.Time); ok {
+		if v.IsZero() {
+			return "", nil
+		}
+		return v.Format(time.RFC3339), nil
+	}
+
+	serialized, err := recurse(input.Type(), input, debugLogger)
+	if err != nil {
+		return nil, fmt.Errorf("serializing nested object %q: %+v", input.Type(), err)
+	}
+	return []interface{}{serialized}, nil
+}
+
+// encodeNestedObjects encodes a slice of structs (or pointers to structs) as the items within a block
+func encodeNestedObjects(input reflect.Value, debugLogger Logger) ([]interface{}, error) {
+	output := make([]interface{}, 0, input.Len())
+	for i := 0; i < input.Len(); i++ {
+		debugLogger.Infof("[SLICE] Index %d is %q", i, input.Index(i).Interface())
+		debugLogger.Infof("[SLICE] Type %+v", input.Type())
+		nestedValue := input.Index(i)
+		if nestedValue.Kind() == reflect.Pointer {
+			if nestedValue.IsNil() {
+				continue
+			}
+			nestedValue = nestedValue.Elem()
+		}
+
+		serialized, err := recurse(nestedValue.Type(), nestedValue, debugLogger)
+		if err != nil {
+			return nil, fmt.Errorf("serializing nested object %q: %+v", input.Type(), err)
+		}
+		output = append(output, serialized)
+	}
+
+	return output, nil
+}
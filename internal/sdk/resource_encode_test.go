@@ -6,6 +6,7 @@ package sdk
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -995,6 +996,170 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleOptionalItems(t *testing.T)
 	}.test(t)
 }
 
+func TestResourceEncode_Pointers(t *testing.T) {
+	type SimpleType struct {
+		Int32   *int32   `tfschema:"int32"`
+		Float32 *float32 `tfschema:"float32"`
+		Omitted *int64   `tfschema:"omitted"`
+	}
+	encodeTestData{
+		Input: &SimpleType{
+			Int32:   pointer.To(int32(42)),
+			Float32: pointer.To(float32(1.5)),
+		},
+		Expected: map[string]interface{}{
+			"int32":   int64(42),
+			"float32": float64(1.5),
+			"omitted": nil,
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedMaps(t *testing.T) {
+	type SimpleType struct {
+		NestedMap    map[string]map[string]string       `tfschema:"nested_map"`
+		NestedMapPtr *map[string]map[string]interface{} `tfschema:"nested_map_ptr"`
+	}
+	encodeTestData{
+		Input: &SimpleType{
+			NestedMap: map[string]map[string]string{
+				"outer": {
+					"inner": "value",
+				},
+			},
+			NestedMapPtr: &map[string]map[string]interface{}{
+				"outer": {
+					"enabled": true,
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"nested_map": map[string]interface{}{
+				"outer": map[string]string{
+					"inner": "value",
+				},
+			},
+			"nested_map_ptr": map[string]interface{}{
+				"outer": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_SliceOfPointers(t *testing.T) {
+	type Inner struct {
+		Name string `tfschema:"name"`
+	}
+	type Type struct {
+		Items    []*Inner  `tfschema:"items"`
+		Optional *[]*Inner `tfschema:"optional"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Items: []*Inner{
+				{
+					Name: "first",
+				},
+				nil,
+				{
+					Name: "second",
+				},
+			},
+			Optional: &[]*Inner{
+				{
+					Name: "optional",
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"items": []interface{}{
+				map[string]interface{}{
+					"name": "first",
+				},
+				map[string]interface{}{
+					"name": "second",
+				},
+			},
+			"optional": []interface{}{
+				map[string]interface{}{
+					"name": "optional",
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_SingleNestedObject(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Required Inner  `tfschema:"required"`
+		Optional *Inner `tfschema:"optional"`
+		Empty    *Inner `tfschema:"empty"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Required: Inner{
+				Value: "required",
+			},
+			Optional: &Inner{
+				Value: "optional",
+			},
+		},
+		Expected: map[string]interface{}{
+			"required": []interface{}{
+				map[string]interface{}{
+					"value": "required",
+				},
+			},
+			"optional": []interface{}{
+				map[string]interface{}{
+					"value": "optional",
+				},
+			},
+			"empty": nil,
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_Time(t *testing.T) {
+	type Inner struct {
+		Expires time.Time `tfschema:"expires"`
+	}
+	type Type struct {
+		Created  time.Time  `tfschema:"created"`
+		Modified *time.Time `tfschema:"modified"`
+		Deleted  *time.Time `tfschema:"deleted"`
+		Unset    time.Time  `tfschema:"unset"`
+		Nested   []Inner    `tfschema:"nested"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Created:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Modified: pointer.To(time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("", 3600))),
+			Nested: []Inner{
+				{
+					Expires: time.Date(2025, 6, 7, 8, 9, 10, 0, time.UTC),
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"created":  "2024-01-02T03:04:05Z",
+			"modified": "2024-01-02T03:04:05+01:00",
+			"deleted":  nil,
+			"unset":    "",
+			"nested": []interface{}{
+				map[string]interface{}{
+					"expires": "2025-06-07T08:09:10Z",
+				},
+			},
+		},
+	}.test(t)
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
@@ -105,6 +105,23 @@ func TestParseStructTags_WithValue(t *testing.T) {
 			expected: nil,
 			error:    pointer.To("the struct-tags `removedInNextMajorVersion` and `addedInNextMajorVersion` cannot be set together"),
 		},
+		{
+			// valid, with explicitlyConfigured
+			input: `tfschema:"hello, explicitlyConfigured"`,
+			expected: &decodedStructTags{
+				hclPath:              "hello",
+				explicitlyConfigured: true,
+			},
+		},
+		{
+			// valid, with explicitlyConfigured and removedInNextMajorVersion
+			input: `tfschema:"hello,explicitlyConfigured,removedInNextMajorVersion"`,
+			expected: &decodedStructTags{
+				hclPath:                   "hello",
+				removedInNextMajorVersion: true,
+				explicitlyConfigured:      true,
+			},
+		},
 		{
 			// invalid, unknown struct tags
 			input:    `tfschema:"hello,world"`,
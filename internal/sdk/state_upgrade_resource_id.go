@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = ResourceIDStateUpgrade{}
+
+// ResourceIDStateUpgrade is a generic State Upgrade which rewrites Resource IDs within the State from an old format
+// into a new format - for example to fix the casing of a segment (e.g. `resourcegroups` to `resourceGroups`) or
+// where a static segment has been renamed.
+//
+// The existing values are parsed case-insensitively using the old Resource ID type, and the user-specified segments
+// (e.g. the Subscription ID, Resource Group and Resource Names) are then used, in order, to build the new Resource
+// ID - as such both Resource ID types must contain the same number of user-specified segments. Values which
+// already match the new Resource ID type are normalized using that instead.
+type ResourceIDStateUpgrade struct {
+	// PointInTimeSchema is a point-in-time reference to the Schema at the time of this version, see the
+	// documentation for `pluginsdk.StateUpgrade` for more information.
+	PointInTimeSchema map[string]*pluginsdk.Schema
+
+	// ID defines the old and new format of the Resource ID for this Resource, which is used to rewrite `id`.
+	ID ResourceIDRewrite
+
+	// Attributes optionally defines other attributes containing Resource IDs which should be rewritten, keyed by
+	// the path to the attribute - where `*` matches every item within a List/Set, for example
+	// `network_interface_ids.*` or `ip_configuration.*.subnet_id`. Empty values are left as-is.
+	Attributes map[string]ResourceIDRewrite
+}
+
+// ResourceIDRewrite defines the old and new Resource ID types, as pointers to the typed Resource IDs (for example
+// `&parse.LegacyWidgetId{}` and `&widgets.WidgetId{}`). Where only the casing of the Resource ID has changed these
+// can be the same type.
+type ResourceIDRewrite struct {
+	Old resourceids.ResourceId
+	New resourceids.ResourceId
+}
+
+func (u ResourceIDStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return u.PointInTimeSchema
+}
+
+func (u ResourceIDStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if u.ID.Old != nil && u.ID.New != nil {
+			oldId, ok := rawState["id"].(string)
+			if !ok {
+				return rawState, fmt.Errorf("expected `id` to be a string but got %T", rawState["id"])
+			}
+
+			newId, err := u.ID.rewrite(oldId)
+			if err != nil {
+				return rawState, fmt.Errorf("rewriting `id`: %+v", err)
+			}
+
+			log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId)
+			rawState["id"] = newId
+		}
+
+		for path, rewrite := range u.Attributes {
+			if err := rewriteResourceIDsAtPath(rawState, strings.Split(path, "."), rewrite); err != nil {
+				return rawState, fmt.Errorf("rewriting %q: %+v", path, err)
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+// rewrite returns the specified Resource ID in the new format
+func (r ResourceIDRewrite) rewrite(input string) (string, error) {
+	// if the Resource ID is already in the new format we only need to normalize the casing
+	if parsed, err := resourceids.NewParserFromResourceIdType(r.New).Parse(input, true); err == nil {
+		return resourceIdFromParseResult(r.New, *parsed)
+	}
+
+	oldParsed, err := resourceids.NewParserFromResourceIdType(r.Old).Parse(input, true)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	oldSegments := userSpecifiedSegments(r.Old.Segments())
+	newSegments := userSpecifiedSegments(r.New.Segments())
+	if len(oldSegments) != len(newSegments) {
+		return "", fmt.Errorf("internal-error: the old Resource ID type %T has %d user-specified segments but the new Resource ID type %T has %d", r.Old, len(oldSegments), r.New, len(newSegments))
+	}
+
+	newParsed := resourceids.ParseResult{
+		Parsed:   make(map[string]string, len(newSegments)),
+		RawInput: input,
+	}
+	for i, segment := range newSegments {
+		newParsed.Parsed[segment.Name] = oldParsed.Parsed[oldSegments[i].Name]
+	}
+	for _, segment := range r.New.Segments() {
+		if segment.FixedValue != nil {
+			newParsed.Parsed[segment.Name] = *segment.FixedValue
+		}
+	}
+
+	return resourceIdFromParseResult(r.New, newParsed)
+}
+
+// resourceIdFromParseResult populates a new instance of the Resource ID type `idType` and returns the formatted ID
+func resourceIdFromParseResult(idType resourceids.ResourceId, input resourceids.ParseResult) (string, error) {
+	t := reflect.TypeOf(idType)
+	if t.Kind() != reflect.Ptr {
+		return "", fmt.Errorf("internal-error: expected a pointer to a Resource ID but got %T", idType)
+	}
+
+	id, ok := reflect.New(t.Elem()).Interface().(resourceids.ResourceId)
+	if !ok {
+		return "", fmt.Errorf("internal-error: %T isn't a Resource ID", idType)
+	}
+	if err := id.FromParseResult(input); err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
+
+// userSpecifiedSegments returns the Segments which don't have a fixed value, in order
+func userSpecifiedSegments(input []resourceids.Segment) []resourceids.Segment {
+	output := make([]resourceids.Segment, 0)
+	for _, v := range input {
+		if v.Type == resourceids.StaticSegmentType || v.Type == resourceids.ResourceProviderSegmentType {
+			continue
+		}
+		output = append(output, v)
+	}
+	return output
+}
+
+// rewriteResourceIDsAtPath rewrites the Resource ID(s) found at the specified path within the raw State
+func rewriteResourceIDsAtPath(input interface{}, path []string, rewrite ResourceIDRewrite) error {
+	if len(path) == 0 {
+		return nil
+	}
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		key := path[0]
+		if len(path) == 1 {
+			raw, ok := v[key]
+			if !ok || raw == nil {
+				return nil
+			}
+
+			value, ok := raw.(string)
+			if !ok {
+				return fmt.Errorf("expected %q to be a string but got %T", key, raw)
+			}
+			if value == "" {
+				return nil
+			}
+
+			newValue, err := rewrite.rewrite(value)
+			if err != nil {
+				return err
+			}
+			v[key] = newValue
+			return nil
+		}
+
+		return rewriteResourceIDsAtPath(v[key], path[1:], rewrite)
+
+	case []interface{}:
+		if path[0] != "*" {
+			return fmt.Errorf("expected a `*` to match the items within a List/Set but got %q", path[0])
+		}
+
+		for i, item := range v {
+			if len(path) == 1 {
+				value, ok := item.(string)
+				if !ok {
+					return fmt.Errorf("expected item %d to be a string but got %T", i, item)
+				}
+				if value == "" {
+					continue
+				}
+
+				newValue, err := rewrite.rewrite(value)
+				if err != nil {
+					return err
+				}
+				v[i] = newValue
+				continue
+			}
+
+			if err := rewriteResourceIDsAtPath(item, path[1:], rewrite); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &legacyDatabaseId{}
+
+// legacyDatabaseId is the old format of databaseId, using the (since renamed) `databases` segment
+type legacyDatabaseId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ServerName        string
+	DatabaseName      string
+}
+
+func (id *legacyDatabaseId) FromParseResult(input resourceids.ParseResult) error {
+	id.SubscriptionId = input.Parsed["subscriptionId"]
+	id.ResourceGroupName = input.Parsed["resourceGroupName"]
+	id.ServerName = input.Parsed["serverName"]
+	id.DatabaseName = input.Parsed["databaseName"]
+	return nil
+}
+
+func (id legacyDatabaseId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Example/servers/%s/databases/%s", id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.DatabaseName)
+}
+
+func (id legacyDatabaseId) String() string {
+	return fmt.Sprintf("Legacy Database %q", id.DatabaseName)
+}
+
+func (id legacyDatabaseId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftExample", "Microsoft.Example", "Microsoft.Example"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "serverValue"),
+		resourceids.StaticSegment("staticDatabases", "databases", "databases"),
+		resourceids.UserSpecifiedSegment("databaseName", "databaseValue"),
+	}
+}
+
+var _ resourceids.ResourceId = &databaseId{}
+
+type databaseId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ServerName        string
+	SqlDatabaseName   string
+}
+
+func (id *databaseId) FromParseResult(input resourceids.ParseResult) error {
+	id.SubscriptionId = input.Parsed["subscriptionId"]
+	id.ResourceGroupName = input.Parsed["resourceGroupName"]
+	id.ServerName = input.Parsed["serverName"]
+	id.SqlDatabaseName = input.Parsed["sqlDatabaseName"]
+	return nil
+}
+
+func (id databaseId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Example/servers/%s/sqlDatabases/%s", id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.SqlDatabaseName)
+}
+
+func (id databaseId) String() string {
+	return fmt.Sprintf("Database %q", id.SqlDatabaseName)
+}
+
+func (id databaseId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftExample", "Microsoft.Example", "Microsoft.Example"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "serverValue"),
+		resourceids.StaticSegment("staticSqlDatabases", "sqlDatabases", "sqlDatabases"),
+		resourceids.UserSpecifiedSegment("sqlDatabaseName", "sqlDatabaseValue"),
+	}
+}
+
+func TestResourceIDStateUpgrade(t *testing.T) {
+	testData := []struct {
+		name     string
+		upgrade  ResourceIDStateUpgrade
+		input    map[string]interface{}
+		expected map[string]interface{}
+		error    bool
+	}{
+		{
+			name: "casing",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDRewrite{
+					Old: &commonids.SubnetId{},
+					New: &commonids.SubnetId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id":   "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1",
+				"name": "subnet1",
+			},
+			expected: map[string]interface{}{
+				"id":   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+				"name": "subnet1",
+			},
+		},
+		{
+			name: "renamed segment",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDRewrite{
+					Old: &legacyDatabaseId{},
+					New: &databaseId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database1",
+			},
+			expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/sqlDatabases/database1",
+			},
+		},
+		{
+			name: "already upgraded",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDRewrite{
+					Old: &legacyDatabaseId{},
+					New: &databaseId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/SQLDatabases/database1",
+			},
+			expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/sqlDatabases/database1",
+			},
+		},
+		{
+			name: "nested attributes",
+			upgrade: ResourceIDStateUpgrade{
+				Attributes: map[string]ResourceIDRewrite{
+					"database_ids.*": {
+						Old: &legacyDatabaseId{},
+						New: &databaseId{},
+					},
+					"ip_configuration.*.subnet_id": {
+						Old: &commonids.SubnetId{},
+						New: &commonids.SubnetId{},
+					},
+				},
+			},
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+				"database_ids": []interface{}{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database1",
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/database2",
+				},
+				"ip_configuration": []interface{}{
+					map[string]interface{}{
+						"name":      "first",
+						"subnet_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+					},
+					map[string]interface{}{
+						"name":      "second",
+						"subnet_id": "",
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				// `id` isn't rewritten since no ID was specified
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+				"database_ids": []interface{}{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/sqlDatabases/database1",
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/sqlDatabases/database2",
+				},
+				"ip_configuration": []interface{}{
+					map[string]interface{}{
+						"name":      "first",
+						"subnet_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+					},
+					map[string]interface{}{
+						"name":      "second",
+						"subnet_id": "",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid id",
+			upgrade: ResourceIDStateUpgrade{
+				ID: ResourceIDRewrite{
+					Old: &legacyDatabaseId{},
+					New: &databaseId{},
+				},
+			},
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1",
+			},
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := v.upgrade.UpgradeFunc()(context.TODO(), v.input, nil)
+		if err != nil {
+			if v.error {
+				continue
+			}
+
+			t.Fatalf("unexpected error for %q: %+v", v.name, err)
+		}
+		if v.error {
+			t.Fatalf("expected an error for %q but didn't get one", v.name)
+		}
+
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v for %q", v.expected, actual, v.name)
+		}
+	}
+}
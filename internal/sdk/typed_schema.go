@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// TypedSchemaType is the type of a field within a TypedSchema
+type TypedSchemaType int
+
+const (
+	TypedSchemaTypeString TypedSchemaType = iota
+	TypedSchemaTypeBool
+	TypedSchemaTypeInt
+	TypedSchemaTypeFloat
+	TypedSchemaTypeList
+	TypedSchemaTypeSet
+	TypedSchemaTypeMap
+)
+
+// TypedSchema describes the Arguments and Attributes of a Resource independently of the Plugin SDK used to
+// serve it. At present this can only be rendered into the types used by Plugin SDKv2, from within the
+// Arguments and Attributes functions of a Resource:
+//
+//	func (r ExampleResource) Arguments() map[string]*pluginsdk.Schema {
+//		return r.typedSchema().PluginSdkArguments()
+//	}
+//
+// Rendering into the Plugin Framework (and serving both through terraform-plugin-mux) is blocked until
+// terraform-plugin-framework and terraform-plugin-mux are dependencies of the Provider.
+type TypedSchema struct {
+	// Arguments is a list of user-configurable (that is: Required, Optional, or Optional and Computed)
+	// arguments for this Resource
+	Arguments map[string]TypedSchemaAttribute
+
+	// Attributes is a list of read-only (e.g. Computed-only) attributes
+	Attributes map[string]TypedSchemaAttribute
+}
+
+// TypedSchemaAttribute describes a single field within a TypedSchema
+type TypedSchemaAttribute struct {
+	Type TypedSchemaType
+
+	// ElementType is the type of each element within a List, Set or Map of primitive values
+	ElementType TypedSchemaType
+
+	// NestedObject is the schema of each element within a List or Set of objects - when specified the
+	// ElementType is ignored
+	NestedObject map[string]TypedSchemaAttribute
+
+	// NestedAsAttribute specifies that the NestedObject is configured as an attribute (`example = [{ ... }]`)
+	// rather than as a block (`example { ... }`)
+	NestedAsAttribute bool
+
+	Required bool
+	Optional bool
+	Computed bool
+	ForceNew bool
+
+	// Sensitive specifies that the value of this field should be redacted in the plan and output
+	Sensitive bool
+
+	MinItems int
+	MaxItems int
+
+	Default      interface{}
+	ValidateFunc pluginsdk.SchemaValidateFunc
+
+	AtLeastOneOf  []string
+	ConflictsWith []string
+	ExactlyOneOf  []string
+	RequiredWith  []string
+
+	Deprecated  string
+	Description string
+}
+
+// PluginSdkSchema renders the Arguments and Attributes of the TypedSchema into the types used by Plugin SDKv2
+func (s TypedSchema) PluginSdkSchema() (arguments map[string]*pluginsdk.Schema, attributes map[string]*pluginsdk.Schema, err error) {
+	arguments, err = pluginSdkSchemaForAttributes(s.Arguments, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("building Arguments: %+v", err)
+	}
+
+	attributes, err = pluginSdkSchemaForAttributes(s.Attributes, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("building Attributes: %+v", err)
+	}
+
+	return arguments, attributes, nil
+}
+
+// PluginSdkArguments renders the Arguments of the TypedSchema into the types used by Plugin SDKv2 - since an invalid
+// TypedSchema is a programming error this panics, which is caught by the unit tests building each Resource
+func (s TypedSchema) PluginSdkArguments() map[string]*pluginsdk.Schema {
+	arguments, _, err := s.PluginSdkSchema()
+	if err != nil {
+		panic(err)
+	}
+	return arguments
+}
+
+// PluginSdkAttributes renders the Attributes of the TypedSchema into the types used by Plugin SDKv2 - since an invalid
+// TypedSchema is a programming error this panics, which is caught by the unit tests building each Resource
+func (s TypedSchema) PluginSdkAttributes() map[string]*pluginsdk.Schema {
+	_, attributes, err := s.PluginSdkSchema()
+	if err != nil {
+		panic(err)
+	}
+	return attributes
+}
+
+func pluginSdkSchemaForAttributes(input map[string]TypedSchemaAttribute, parent string) (map[string]*pluginsdk.Schema, error) {
+	output := make(map[string]*pluginsdk.Schema, len(input))
+	for k, v := range input {
+		path := k
+		if parent != "" {
+			path = fmt.Sprintf("%s.%s", parent, k)
+		}
+
+		item, err := v.pluginSdkSchema(path)
+		if err != nil {
+			return nil, err
+		}
+		output[k] = item
+	}
+
+	return output, nil
+}
+
+func (a TypedSchemaAttribute) pluginSdkSchema(path string) (*pluginsdk.Schema, error) {
+	valueType, err := a.Type.pluginSdkValueType()
+	if err != nil {
+		return nil, fmt.Errorf("%q: %+v", path, err)
+	}
+
+	output := &pluginsdk.Schema{
+		Type:          valueType,
+		Required:      a.Required,
+		Optional:      a.Optional,
+		Computed:      a.Computed,
+		ForceNew:      a.ForceNew,
+		Sensitive:     a.Sensitive,
+		MinItems:      a.MinItems,
+		MaxItems:      a.MaxItems,
+		Default:       a.Default,
+		ValidateFunc:  a.ValidateFunc,
+		AtLeastOneOf:  a.AtLeastOneOf,
+		ConflictsWith: a.ConflictsWith,
+		ExactlyOneOf:  a.ExactlyOneOf,
+		RequiredWith:  a.RequiredWith,
+		Deprecated:    a.Deprecated,
+		Description:   a.Description,
+	}
+
+	switch a.Type {
+	case TypedSchemaTypeList, TypedSchemaTypeSet, TypedSchemaTypeMap:
+		if a.NestedObject != nil {
+			if a.Type == TypedSchemaTypeMap {
+				return nil, fmt.Errorf("%q: a Map cannot contain a Nested Object", path)
+			}
+
+			nested, err := pluginSdkSchemaForAttributes(a.NestedObject, path)
+			if err != nil {
+				return nil, err
+			}
+			output.Elem = &pluginsdk.Resource{
+				Schema: nested,
+			}
+
+			if a.NestedAsAttribute {
+				output.ConfigMode = pluginsdk.SchemaConfigModeAttr
+			}
+
+			return output, nil
+		}
+
+		elementType, err := a.ElementType.pluginSdkValueType()
+		if err != nil {
+			return nil, fmt.Errorf("%q: %+v", path, err)
+		}
+		if elementType == pluginsdk.TypeList || elementType == pluginsdk.TypeSet || elementType == pluginsdk.TypeMap {
+			return nil, fmt.Errorf("%q: the Element Type must be a primitive type, use a Nested Object instead", path)
+		}
+		output.Elem = &pluginsdk.Schema{
+			Type: elementType,
+		}
+
+	default:
+		if a.NestedObject != nil {
+			return nil, fmt.Errorf("%q: only a List or Set can contain a Nested Object", path)
+		}
+	}
+
+	return output, nil
+}
+
+func (t TypedSchemaType) pluginSdkValueType() (pluginsdk.ValueType, error) {
+	switch t {
+	case TypedSchemaTypeString:
+		return pluginsdk.TypeString, nil
+	case TypedSchemaTypeBool:
+		return pluginsdk.TypeBool, nil
+	case TypedSchemaTypeInt:
+		return pluginsdk.TypeInt, nil
+	case TypedSchemaTypeFloat:
+		return pluginsdk.TypeFloat, nil
+	case TypedSchemaTypeList:
+		return pluginsdk.TypeList, nil
+	case TypedSchemaTypeSet:
+		return pluginsdk.TypeSet, nil
+	case TypedSchemaTypeMap:
+		return pluginsdk.TypeMap, nil
+	}
+
+	return pluginsdk.TypeInvalid, fmt.Errorf("unsupported type %d", t)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type typedSchemaResource struct{}
+
+type typedSchemaModel struct {
+	Name    string            `tfschema:"name"`
+	Rules   []typedSchemaRule `tfschema:"rule"`
+	Tags    map[string]string `tfschema:"tags"`
+	Enabled bool              `tfschema:"enabled"`
+}
+
+type typedSchemaRule struct {
+	Priority int `tfschema:"priority"`
+}
+
+func (r typedSchemaResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.typedSchema().PluginSdkArguments()
+}
+
+func (r typedSchemaResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.typedSchema().PluginSdkAttributes()
+}
+
+func (typedSchemaResource) typedSchema() TypedSchema {
+	return TypedSchema{
+		Arguments: map[string]TypedSchemaAttribute{
+			"name": {
+				Type:         TypedSchemaTypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"rule": {
+				Type:              TypedSchemaTypeList,
+				Optional:          true,
+				ForceNew:          true,
+				NestedAsAttribute: true,
+				NestedObject: map[string]TypedSchemaAttribute{
+					"priority": {
+						Type:     TypedSchemaTypeInt,
+						Required: true,
+					},
+				},
+			},
+			"tags": {
+				Type:        TypedSchemaTypeMap,
+				ElementType: TypedSchemaTypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+		Attributes: map[string]TypedSchemaAttribute{
+			"enabled": {
+				Type: TypedSchemaTypeBool,
+			},
+		},
+	}
+}
+
+func (typedSchemaResource) ModelObject() interface{} {
+	return &typedSchemaModel{}
+}
+
+func (typedSchemaResource) ResourceType() string {
+	return "azurerm_typed_schema"
+}
+
+func (typedSchemaResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: time.Minute,
+	}
+}
+
+func (r typedSchemaResource) Read() ResourceFunc {
+	return r.Create()
+}
+
+func (r typedSchemaResource) Delete() ResourceFunc {
+	return r.Create()
+}
+
+func (typedSchemaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.StringIsNotEmpty
+}
+
+func TestTypedSchemaRendersToPluginSdk(t *testing.T) {
+	wrapper := NewResourceWrapper(typedSchemaResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	name := resource.Schema["name"]
+	if name.Type != pluginsdk.TypeString || !name.Required || !name.ForceNew || name.ValidateFunc == nil {
+		t.Fatalf("expected `name` to be a Required, ForceNew String with validation but got %+v", name)
+	}
+
+	rule := resource.Schema["rule"]
+	if rule.Type != pluginsdk.TypeList || rule.ConfigMode != pluginsdk.SchemaConfigModeAttr {
+		t.Fatalf("expected `rule` to be a List configured as an Attribute but got %+v", rule)
+	}
+	nested, ok := rule.Elem.(*pluginsdk.Resource)
+	if !ok || nested.Schema["priority"].Type != pluginsdk.TypeInt {
+		t.Fatalf("expected `rule` to contain a Nested Object with `priority` but got %+v", rule.Elem)
+	}
+
+	tags := resource.Schema["tags"]
+	if elem, ok := tags.Elem.(*pluginsdk.Schema); !ok || elem.Type != pluginsdk.TypeString {
+		t.Fatalf("expected `tags` to be a Map of Strings but got %+v", tags.Elem)
+	}
+
+	if enabled := resource.Schema["enabled"]; !enabled.Computed {
+		t.Fatalf("expected the Attribute `enabled` to be Computed")
+	}
+
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("validating Resource: %+v", err)
+	}
+}
+
+func TestTypedSchemaInvalid(t *testing.T) {
+	testCases := map[string]TypedSchemaAttribute{
+		"nested object in a map": {
+			Type:     TypedSchemaTypeMap,
+			Optional: true,
+			NestedObject: map[string]TypedSchemaAttribute{
+				"example": {
+					Type:     TypedSchemaTypeString,
+					Optional: true,
+				},
+			},
+		},
+		"nested object in a string": {
+			Type:     TypedSchemaTypeString,
+			Optional: true,
+			NestedObject: map[string]TypedSchemaAttribute{
+				"example": {
+					Type:     TypedSchemaTypeString,
+					Optional: true,
+				},
+			},
+		},
+		"list of lists": {
+			Type:        TypedSchemaTypeList,
+			ElementType: TypedSchemaTypeList,
+			Optional:    true,
+		},
+	}
+
+	for name, attribute := range testCases {
+		t.Logf("[DEBUG] Testing %q", name)
+
+		input := TypedSchema{
+			Arguments: map[string]TypedSchemaAttribute{
+				"example": attribute,
+			},
+		}
+		if _, _, err := input.PluginSdkSchema(); err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", name)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 // DataSourceWrapper is a wrapper for converting a DataSource implementation
@@ -72,5 +73,9 @@ and we recommend using the %[2]q datasource instead.
 }
 
 func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
-	return diagnosticsWrapper(in, dw.logger)
+	return diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		// attribute any HTTP requests made during this operation to this data source, for tracing purposes
+		ctx = common.WithTracedResource(ctx, dw.dataSource.ResourceType(), nil)
+		return in(ctx, d, meta)
+	}, dw.logger)
 }
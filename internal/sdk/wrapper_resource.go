@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -52,13 +53,17 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
-			err := rw.resource.Create().Func(ctx, metaData)
+			create := rw.resource.Create()
+			err := create.Func(ctx, metaData)
 			if err != nil {
 				return err
 			}
 			// NOTE: whilst this may look like we should use the Read
 			// functions timeout here, we're still /technically/ in the
 			// Create function so reusing that timeout should be sufficient
+			if create.ConsistencyCheck != nil {
+				return rw.readUntilConsistent(ctx, metaData, *create.ConsistencyCheck)
+			}
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
 
@@ -118,13 +123,17 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
 
-			err := v.Update().Func(ctx, metaData)
+			update := v.Update()
+			err := update.Func(ctx, metaData)
 			if err != nil {
 				return err
 			}
 			// whilst this may look like we should use the Update timeout here
 			// we're still "technically" in the update method, so reusing the
 			// Update's timeout should be fine
+			if update.ConsistencyCheck != nil {
+				return rw.readUntilConsistent(ctx, metaData, *update.ConsistencyCheck)
+			}
 			return rw.resource.Read().Func(ctx, metaData)
 		})
 		resource.Timeouts.Update = d(v.Update().Timeout)
@@ -183,7 +192,11 @@ and we recommend using the %[2]q resource instead.
 }
 
 func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return diagnosticsWrapper(in, rw.logger)
+	return diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		// attribute any HTTP requests made during this operation to this resource, for tracing purposes
+		ctx = common.WithTracedResource(ctx, rw.resource.ResourceType(), d.Id)
+		return in(ctx, d, meta)
+	}, rw.logger)
 }
 
 func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
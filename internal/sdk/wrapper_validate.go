@@ -49,9 +49,12 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 		field := objType.Field(i)
 		fieldVal := objVal.Field(i)
 
-		if field.Type.Kind() == reflect.Slice {
+		if field.Type.Kind() == reflect.Slice && !isTimeType(field.Type.Elem()) {
 			sv := fieldVal.Slice(0, fieldVal.Len())
 			innerType := sv.Type().Elem()
+			if innerType.Kind() == reflect.Pointer {
+				innerType = innerType.Elem()
+			}
 			innerVal := reflect.Indirect(reflect.New(innerType))
 			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 			if err := validateModelObjectRecursively(fieldName, innerType, innerVal); err != nil {
@@ -59,6 +62,15 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 			}
 		}
 
+		// a block containing a single item can also be represented as a struct, or a pointer to one
+		if objType, ok := nestedObjectType(field.Type); ok {
+			innerVal := reflect.Indirect(reflect.New(objType))
+			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+			if err := validateModelObjectRecursively(fieldName, objType, innerVal); err != nil {
+				return err
+			}
+		}
+
 		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 		structTags, err := parseStructTags(field.Tag)
 		if err != nil {
@@ -67,6 +79,18 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 		if structTags == nil {
 			return fmt.Errorf("field %q is missing a struct tag for `tfschema`", fieldName)
 		}
+		if structTags.explicitlyConfigured {
+			// the configuration is only checked for top-level fields, and a field which isn't configured
+			// has to be distinguishable from one set to the zero value
+			if prefix != "" {
+				return fmt.Errorf("field %q uses the `explicitlyConfigured` struct tag option which is only supported for top-level fields", fieldName)
+			}
+			switch field.Type.Kind() {
+			case reflect.Pointer, reflect.Slice, reflect.Map:
+			default:
+				return fmt.Errorf("field %q uses the `explicitlyConfigured` struct tag option and so must be a pointer, slice or map", fieldName)
+			}
+		}
 	}
 
 	return nil
@@ -3,7 +3,10 @@
 
 package sdk
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -69,3 +72,67 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateSingleNestedObjectValid(t *testing.T) {
+	type Pet struct {
+		Name    string    `tfschema:"name"`
+		Adopted time.Time `tfschema:"adopted"`
+	}
+	type Person struct {
+		Name     string      `tfschema:"name"`
+		Pet      *Pet        `tfschema:"pet"`
+		Pets     []*Pet      `tfschema:"pets"`
+		Birthday *time.Time  `tfschema:"birthday"`
+		Holidays []time.Time `tfschema:"holidays"`
+	}
+	if err := ValidateModelObject(&Person{}); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateSingleNestedObjectInvalid(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+		Age  int
+	}
+	type Person struct {
+		Name string `tfschema:"name"`
+		Pet  Pet    `tfschema:"pet"`
+	}
+	if err := ValidateModelObject(&Person{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateExplicitlyConfiguredValid(t *testing.T) {
+	type Person struct {
+		Name    string            `tfschema:"name"`
+		Age     *int              `tfschema:"age,explicitlyConfigured"`
+		Aliases []string          `tfschema:"aliases,explicitlyConfigured"`
+		Tags    map[string]string `tfschema:"tags,explicitlyConfigured"`
+	}
+	if err := ValidateModelObject(&Person{}); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateExplicitlyConfiguredInvalid(t *testing.T) {
+	t.Log("NotNillable")
+	type NotNillable struct {
+		Age int `tfschema:"age,explicitlyConfigured"`
+	}
+	if err := ValidateModelObject(&NotNillable{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("Nested")
+	type Pet struct {
+		Name *string `tfschema:"name,explicitlyConfigured"`
+	}
+	type Nested struct {
+		Pets []Pet `tfschema:"pets"`
+	}
+	if err := ValidateModelObject(&Nested{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apiversionset"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -329,9 +330,10 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 			},
 
 			"version_set_id": {
-				Type:     pluginsdk.TypeString,
-				Computed: true,
-				Optional: true,
+				Type:         pluginsdk.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.ResourceIDOfType(&apiversionset.ApiVersionSetId{}),
 			},
 		},
 	}
@@ -1005,7 +1005,7 @@ func resourceApiManagementServiceUpdate(d *pluginsdk.ResourceData, meta interfac
 		payload.Sku = pointer.To(sku)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -6,6 +6,7 @@ package appconfiguration
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Azure/go-autorest/autorest"
@@ -81,3 +82,10 @@ func appConfigurationGetKeyRefreshFunc(ctx context.Context, client *appconfigura
 		return res, "Exists", nil
 	}
 }
+
+// appConfigurationKeyIsPending returns whether the error returned when retrieving a key means that it's still being
+// provisioned, since a 403 is returned until a newly created key is available
+func appConfigurationKeyIsPending(err error) bool {
+	var v autorest.DetailedError
+	return errors.As(err, &v) && response.WasForbidden(v.Response)
+}
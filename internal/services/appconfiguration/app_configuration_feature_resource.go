@@ -305,25 +305,17 @@ func (k FeatureResource) Create() sdk.ResourceFunc {
 				}
 			}
 
-			// https://github.com/Azure/AppConfiguration/issues/763
-			metadata.Logger.Infof("[DEBUG] Waiting for App Configuration Feature %q to be provisioned", model.Key)
-			stateConf = &pluginsdk.StateChangeConf{
-				Pending:                   []string{"NotFound", "Forbidden"},
-				Target:                    []string{"Exists"},
-				Refresh:                   appConfigurationGetKeyRefreshFunc(ctx, client, featureKey, model.Label),
-				PollInterval:              5 * time.Second,
-				ContinuousTargetOccurence: 4,
-				Timeout:                   time.Until(deadline),
-			}
-
-			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-				return fmt.Errorf("waiting for App Configuration Feature %q to be provisioned: %+v", featureKey, err)
-			}
-
 			metadata.SetID(nestedItemId)
 			return nil
 		},
 		Timeout: 45 * time.Minute,
+		// newly created features aren't always returned immediately: https://github.com/Azure/AppConfiguration/issues/763
+		ConsistencyCheck: &sdk.ConsistencyCheck{
+			Fields:                    []string{"description", "enabled", "percentage_filter_value"},
+			IsPending:                 appConfigurationKeyIsPending,
+			PollInterval:              5 * time.Second,
+			ContinuousTargetOccurence: 4,
+		},
 	}
 }
 
@@ -377,7 +369,7 @@ func (k FeatureResource) Read() sdk.ResourceFunc {
 						return metadata.MarkAsGone(nestedItemId)
 					}
 				}
-				return fmt.Errorf("while checking for key %q existence: %+v", *nestedItemId, err)
+				return fmt.Errorf("while checking for key %q existence: %w", *nestedItemId, err)
 			}
 
 			var fv FeatureValue
@@ -464,7 +456,7 @@ func (k FeatureResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("while unlocking key/label pair %s/%s: %+v", nestedItemId.Key, nestedItemId.Label, err)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				kv.Tags = tags.Expand(model.Tags)
 			}
 
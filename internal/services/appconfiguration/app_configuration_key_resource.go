@@ -221,25 +221,17 @@ func (k KeyResource) Create() sdk.ResourceFunc {
 				}
 			}
 
-			// https://github.com/Azure/AppConfiguration/issues/763
-			metadata.Logger.Infof("[DEBUG] Waiting for App Configuration Key %q to be provisioned", model.Key)
-			stateConf = &pluginsdk.StateChangeConf{
-				Pending:                   []string{"NotFound", "Forbidden"},
-				Target:                    []string{"Exists"},
-				Refresh:                   appConfigurationGetKeyRefreshFunc(ctx, client, model.Key, model.Label),
-				PollInterval:              5 * time.Second,
-				ContinuousTargetOccurence: 4,
-				Timeout:                   time.Until(deadline),
-			}
-
-			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-				return fmt.Errorf("waiting for App Configuration Key %q to be provisioned: %+v", model.Key, err)
-			}
-
 			metadata.SetID(nestedItemId)
 			return nil
 		},
 		Timeout: 45 * time.Minute,
+		// newly created keys aren't always returned immediately: https://github.com/Azure/AppConfiguration/issues/763
+		ConsistencyCheck: &sdk.ConsistencyCheck{
+			Fields:                    []string{"key", "label", "value"},
+			IsPending:                 appConfigurationKeyIsPending,
+			PollInterval:              5 * time.Second,
+			ContinuousTargetOccurence: 4,
+		},
 	}
 }
 
@@ -295,7 +287,7 @@ func (k KeyResource) Read() sdk.ResourceFunc {
 				} else {
 					return fmt.Errorf("while checking for key %q existence: %+v", *nestedItemId, err)
 				}
-				return fmt.Errorf("while checking for key %q existence: %+v", *nestedItemId, err)
+				return fmt.Errorf("while checking for key %q existence: %w", *nestedItemId, err)
 			}
 
 			model := KeyResourceModel{
@@ -358,7 +350,7 @@ func (k KeyResource) Update() sdk.ResourceFunc {
 
 			metadata.Client.AppConfiguration.AddToCache(*configurationStoreId, nestedItemId.ConfigurationStoreEndpoint)
 
-			if metadata.ResourceData.HasChange("value") || metadata.ResourceData.HasChange("content_type") || metadata.ResourceData.HasChange("tags") || metadata.ResourceData.HasChange("type") || metadata.ResourceData.HasChange("vault_key_reference") {
+			if metadata.ResourceData.HasChange("value") || metadata.ResourceData.HasChange("content_type") || metadata.ResourceData.HasChanges("tags", "tags_all") || metadata.ResourceData.HasChange("type") || metadata.ResourceData.HasChange("vault_key_reference") {
 				entity := appconfiguration.KeyValue{
 					Key:   utils.String(model.Key),
 					Label: utils.String(model.Label),
@@ -467,7 +467,7 @@ func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 				model.Properties.ClusterSettings = expandClusterSettingsModel(state.ClusterSetting)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(state.Tags)
 			}
 
@@ -336,7 +336,7 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 				model.Sku.Name = utils.String(state.Sku)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = pointer.To(state.Tags)
 			}
 
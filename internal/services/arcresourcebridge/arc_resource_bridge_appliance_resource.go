@@ -192,7 +192,7 @@ func (r ArcResourceBridgeApplianceResource) Update() sdk.ResourceFunc {
 				parameters.Identity = identity
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = tags.Expand(model.Tags)
 			}
 
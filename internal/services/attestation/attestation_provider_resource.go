@@ -333,7 +333,7 @@ func resourceAttestationProviderUpdate(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload := attestationproviders.AttestationServicePatchParams{
 			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 		}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -261,16 +262,14 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
-	read, err := roleAssignmentsClient.Get(ctx, scope, name, tenantId)
-	if err != nil {
-		return err
-	}
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read Role Assignment ID for %q (Scope %q)", name, scope)
-	}
-
-	d.SetId(parse.ConstructRoleAssignmentId(*read.ID, tenantId))
-	return resourceArmRoleAssignmentRead(d, meta)
+	// newly created Role Assignments aren't returned until these have finished replicating
+	return sdk.ReadUntilConsistent(ctx, d, sdk.ConsistencyCheck{
+		Fields:                    []string{"principal_id"},
+		PollInterval:              5 * time.Second,
+		ContinuousTargetOccurence: 5,
+	}, func() error {
+		return resourceArmRoleAssignmentRead(d, meta)
+	})
 }
 
 func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -377,23 +376,7 @@ func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name st
 			return pluginsdk.NonRetryableError(fmt.Errorf("creation of Role Assignment %q did not return an id value", name))
 		}
 
-		stateConf := &pluginsdk.StateChangeConf{
-			Pending: []string{
-				"pending",
-			},
-			Target: []string{
-				"ready",
-			},
-			Refresh:                   roleAssignmentCreateStateRefreshFunc(ctx, roleAssignmentsClient, *resp.ID, tenantId),
-			MinTimeout:                5 * time.Second,
-			ContinuousTargetOccurence: 5,
-			Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
-		}
-
-		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-			return pluginsdk.NonRetryableError(fmt.Errorf("failed waiting for Role Assignment %q to finish replicating: %+v", name, err))
-		}
-
+		d.SetId(parse.ConstructRoleAssignmentId(*resp.ID, tenantId))
 		return nil
 	}
 }
@@ -426,19 +409,6 @@ func parseRoleAssignmentId(input string) (*roleAssignmentId, error) {
 	return &id, nil
 }
 
-func roleAssignmentCreateStateRefreshFunc(ctx context.Context, client *authorization.RoleAssignmentsClient, roleID string, tenantId string) pluginsdk.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := client.GetByID(ctx, roleID, tenantId)
-		if err != nil {
-			if utils.ResponseWasNotFound(resp.Response) {
-				return resp, "pending", nil
-			}
-			return resp, "failed", err
-		}
-		return resp, "ready", nil
-	}
-}
-
 func getTenantIdBySubscriptionId(ctx context.Context, client *subscriptions.SubscriptionsClient, subscriptionId string) (string, error) {
 	id := commonids.NewSubscriptionID(subscriptionId)
 	resp, err := client.Get(ctx, id)
@@ -233,7 +233,7 @@ func (r PowerShell72ModuleResource) Update() sdk.ResourceFunc {
 				},
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = tags.Expand(model.Tags)
 			}
 
@@ -217,7 +217,7 @@ func (m Python3PackageResource) Update() sdk.ResourceFunc {
 			}
 
 			var upd python3package.PythonPackageUpdateParameters
-			if meta.ResourceData.HasChange("tags") {
+			if meta.ResourceData.HasChanges("tags", "tags_all") {
 				upd.Tags = &model.Tags
 			}
 
@@ -251,7 +251,7 @@ func resourceArmStackHCIClusterUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	cluster := clusters.ClusterPatch{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		cluster.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
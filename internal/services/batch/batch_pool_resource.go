@@ -474,7 +474,7 @@ func resourceBatchPool() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: commonids.ValidateSubnetID,
 						},
 						"public_ips": {
 							Type:     pluginsdk.TypeSet,
@@ -1614,8 +1614,9 @@ func startTaskSchema() map[string]*pluginsdk.Schema {
 						Optional: true,
 					},
 					"user_assigned_identity_id": {
-						Type:     pluginsdk.TypeString,
-						Optional: true,
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.ResourceIDOfType(&commonids.UserAssignedIdentityId{}),
 					},
 				},
 			},
@@ -160,7 +160,7 @@ func resourceHealthbotServiceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
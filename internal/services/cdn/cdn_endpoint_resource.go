@@ -357,7 +357,7 @@ func resourceCdnEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	if updateTypePATCH {
 		log.Printf("[INFO] No changes detected using PATCH for Azure ARM CDN EndPoint update.")
 
-		if !d.HasChange("tags") {
+		if !d.HasChanges("tags", "tags_all") {
 			log.Printf("[INFO] 'tags' did not change, skipping Azure ARM CDN EndPoint update.")
 			return resourceCdnEndpointRead(d, meta)
 		}
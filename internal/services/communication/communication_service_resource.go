@@ -193,7 +193,7 @@ func (r CommunicationServiceResource) Update() sdk.ResourceFunc {
 
 			existing.Model.Properties = &props
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				commService.Tags = pointer.To(model.Tags)
 			}
 
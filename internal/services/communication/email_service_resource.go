@@ -153,7 +153,7 @@ func (r EmailCommunicationServiceResource) Update() sdk.ResourceFunc {
 				props.DataLocation = model.DataLocation
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				emailService.Tags = pointer.To(model.Tags)
 			}
 
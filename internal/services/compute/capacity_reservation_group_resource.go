@@ -142,7 +142,7 @@ func resourceCapacityReservationGroupUpdate(d *pluginsdk.ResourceData, meta inte
 
 	parameters := capacityreservationgroups.CapacityReservationGroupUpdate{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -195,7 +195,7 @@ func resourceCapacityReservationUpdate(d *pluginsdk.ResourceData, meta interface
 	if d.HasChange("sku") {
 		payload.Sku = pointer.To(expandCapacityReservationSku(d.Get("sku").([]interface{})))
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = ComputeUsagesDataSource{}
+
+type ComputeUsagesDataSource struct{}
+
+type ComputeUsagesDataSourceModel struct {
+	Location string              `tfschema:"location"`
+	Usages   []ComputeUsageModel `tfschema:"usages"`
+}
+
+type ComputeUsageModel struct {
+	Name         string `tfschema:"name"`
+	DisplayName  string `tfschema:"display_name"`
+	CurrentValue int64  `tfschema:"current_value"`
+	Limit        int64  `tfschema:"limit"`
+	Unit         string `tfschema:"unit"`
+}
+
+func (r ComputeUsagesDataSource) ResourceType() string {
+	return "azurerm_compute_usages"
+}
+
+func (r ComputeUsagesDataSource) ModelObject() interface{} {
+	return &ComputeUsagesDataSourceModel{}
+}
+
+func (r ComputeUsagesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationWithoutForceNew(),
+	}
+}
+
+func (r ComputeUsagesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"usages": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"current_value": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+					"limit": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+					"unit": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ComputeUsagesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := sdkhacks.NewUsagesClient(metadata.Client.Compute.VirtualMachinesClient)
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+
+			var model ComputeUsagesDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			normalizedLocation := location.Normalize(model.Location)
+
+			resp, err := client.List(ctx, subscriptionId, normalizedLocation)
+			if err != nil {
+				return fmt.Errorf("retrieving Compute Usages for %s in %q: %+v", subscriptionId, normalizedLocation, err)
+			}
+
+			state := ComputeUsagesDataSourceModel{
+				Location: normalizedLocation,
+				Usages:   make([]ComputeUsageModel, 0),
+			}
+			for _, v := range pointer.From(resp.Model) {
+				state.Usages = append(state.Usages, ComputeUsageModel{
+					Name:         pointer.From(v.Name.Value),
+					DisplayName:  pointer.From(v.Name.LocalizedValue),
+					CurrentValue: v.CurrentValue,
+					Limit:        v.Limit,
+					Unit:         v.Unit,
+				})
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.Compute/locations/%s/usages", subscriptionId.ID(), normalizedLocation))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ComputeUsagesDataSource struct{}
+
+func TestAccComputeUsagesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_compute_usages", "test")
+	d := ComputeUsagesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("usages.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("usages.0.name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("usages.0.limit").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (ComputeUsagesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_compute_usages" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
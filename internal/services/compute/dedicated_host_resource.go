@@ -257,7 +257,7 @@ func resourceDedicatedHostUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
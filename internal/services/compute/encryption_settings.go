@@ -4,10 +4,12 @@
 package compute
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -41,8 +43,9 @@ func encryptionSettingsSchema() *pluginsdk.Schema {
 								},
 
 								"source_vault_id": {
-									Type:     pluginsdk.TypeString,
-									Required: true,
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.ResourceIDOfType(&commonids.KeyVaultId{}),
 								},
 							},
 						},
@@ -59,8 +62,9 @@ func encryptionSettingsSchema() *pluginsdk.Schema {
 								},
 
 								"source_vault_id": {
-									Type:     pluginsdk.TypeString,
-									Required: true,
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.ResourceIDOfType(&commonids.KeyVaultId{}),
 								},
 							},
 						},
@@ -88,8 +92,9 @@ func encryptionSettingsSchema() *pluginsdk.Schema {
 							},
 
 							"source_vault_id": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.ResourceIDOfType(&commonids.KeyVaultId{}),
 							},
 						},
 					},
@@ -106,8 +111,9 @@ func encryptionSettingsSchema() *pluginsdk.Schema {
 							},
 
 							"source_vault_id": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.ResourceIDOfType(&commonids.KeyVaultId{}),
 							},
 						},
 					},
@@ -300,7 +300,7 @@ func (r GalleryApplicationResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = pointer.To(state.Tags)
 			}
 
@@ -407,7 +407,7 @@ func (r GalleryApplicationVersionResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = pointer.To(state.Tags)
 			}
 
@@ -418,6 +418,11 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			virtualMachineSkuAvailabilityCustomizeDiff,
+			virtualMachineQuotaCustomizeDiff("azurerm_linux_virtual_machine"),
+		),
 	}
 }
 
@@ -1471,7 +1476,7 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		shouldUpdate = true
 
 		tagsRaw := d.Get("tags").(map[string]interface{})
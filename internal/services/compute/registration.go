@@ -82,6 +82,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		ComputeUsagesDataSource{},
 		OrchestratedVirtualMachineScaleSetDataSource{},
 	}
 }
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// TODO: remove this once the Compute `usage` API is available in go-azure-sdk
+
+type UsagesClient struct {
+	client *virtualmachines.VirtualMachinesClient
+}
+
+// NewUsagesClient returns a client for the Compute Usages API, reusing the (identically versioned) Virtual Machines client
+func NewUsagesClient(client *virtualmachines.VirtualMachinesClient) UsagesClient {
+	return UsagesClient{
+		client: client,
+	}
+}
+
+type Usage struct {
+	CurrentValue int64     `json:"currentValue"`
+	Limit        int64     `json:"limit"`
+	Name         UsageName `json:"name"`
+	Unit         string    `json:"unit"`
+}
+
+type UsageName struct {
+	LocalizedValue *string `json:"localizedValue,omitempty"`
+	Value          *string `json:"value,omitempty"`
+}
+
+type ListUsagesOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]Usage
+}
+
+// List retrieves the current Compute resource usage, and the limits of each quota, within the specified Location
+func (c UsagesClient) List(ctx context.Context, id commonids.SubscriptionId, location string) (result ListUsagesOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       fmt.Sprintf("%s/providers/Microsoft.Compute/locations/%s/usages", id.ID(), location),
+	}
+
+	req, err := c.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.ExecutePaged(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]Usage `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
@@ -255,7 +255,7 @@ func resourceSharedImageGalleryUpdate(d *pluginsdk.ResourceData, meta interface{
 		payload.Properties.Description = pointer.To(d.Get("description").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package skuavailability
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+// the Resource SKUs list is large and only changes when Azure rolls out (or restricts) a Virtual Machine Size,
+// so this is retrieved once per Subscription/Location for the lifetime of the provider process
+var cache = &skuCache{
+	entries: make(map[string]*skuCacheEntry),
+}
+
+type skuCache struct {
+	lock    sync.Mutex
+	entries map[string]*skuCacheEntry
+}
+
+type skuCacheEntry struct {
+	lock   sync.Mutex
+	loaded bool
+	skus   []skus.ResourceSku
+}
+
+func (c *skuCache) entry(subscriptionId, location string) *skuCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := strings.ToLower(fmt.Sprintf("%s/%s", subscriptionId, location))
+	e, ok := c.entries[key]
+	if !ok {
+		e = &skuCacheEntry{}
+		c.entries[key] = e
+	}
+	return e
+}
+
+// virtualMachineSkus returns the Virtual Machine Resource SKUs available in the specified Subscription and Location,
+// retrieving these from the API the first time they're requested
+func (c *skuCache) virtualMachineSkus(ctx context.Context, client *skus.SkusClient, subscriptionId, loc string) ([]skus.ResourceSku, error) {
+	loc = location.Normalize(loc)
+
+	// the lock is held whilst retrieving the list so that resources planned in parallel share a single request
+	e := c.entry(subscriptionId, loc)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.loaded {
+		return e.skus, nil
+	}
+
+	id := commonids.NewSubscriptionID(subscriptionId)
+	opts := skus.DefaultResourceSkusListOperationOptions()
+	// by default this API returns every SKU in every Location, so we filter to the requested Location only
+	opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", loc))
+
+	log.Printf("[DEBUG] Retrieving the Resource SKUs available in %q for %s..", loc, id)
+	resp, err := client.ResourceSkusListComplete(ctx, id, opts)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Resource SKUs available in %q for %s: %+v", loc, id, err)
+	}
+
+	result := make([]skus.ResourceSku, 0)
+	for _, item := range resp.Items {
+		if item.ResourceType == nil || !strings.EqualFold(*item.ResourceType, "virtualMachines") {
+			continue
+		}
+		result = append(result, item)
+	}
+
+	e.skus = result
+	e.loaded = true
+
+	return e.skus, nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package skuavailability
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+// Requirements describes the Virtual Machine Size a resource intends to use, and which features of it are required
+type Requirements struct {
+	// Size is the name of the Virtual Machine Size, for example `Standard_D8ds_v5`
+	Size string
+
+	// Zones is the list of Availability Zones the Virtual Machine(s) will be deployed into, if any
+	Zones []string
+
+	AcceleratedNetworking bool
+	EphemeralOSDisk       bool
+	PremiumStorage        bool
+}
+
+// ValidateVirtualMachineSize confirms that the Virtual Machine Size described in `requirements` is offered in the
+// specified Location (and Zones) for this Subscription, and that it supports the requested capabilities.
+func ValidateVirtualMachineSize(ctx context.Context, client *skus.SkusClient, subscriptionId, loc string, requirements Requirements) error {
+	available, err := cache.virtualMachineSkus(ctx, client, subscriptionId, loc)
+	if err != nil {
+		return err
+	}
+
+	return validateRequirements(available, location.Normalize(loc), requirements)
+}
+
+// VirtualMachineSku returns the Resource SKU for the specified Virtual Machine Size within the Location, or nil if it's not offered
+func VirtualMachineSku(ctx context.Context, client *skus.SkusClient, subscriptionId, loc, size string) (*skus.ResourceSku, error) {
+	available, err := cache.virtualMachineSkus(ctx, client, subscriptionId, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	return findSku(available, size), nil
+}
+
+func findSku(available []skus.ResourceSku, size string) *skus.ResourceSku {
+	for _, item := range available {
+		if strings.EqualFold(pointer.From(item.Name), size) {
+			return pointer.To(item)
+		}
+	}
+	return nil
+}
+
+func validateRequirements(available []skus.ResourceSku, loc string, requirements Requirements) error {
+	sku := findSku(available, requirements.Size)
+	if sku == nil {
+		return fmt.Errorf("the Virtual Machine Size %q is not available in %q", requirements.Size, loc)
+	}
+
+	problems := make([]string, 0)
+
+	restrictedZones := make(map[string]string)
+	for _, restriction := range pointer.From(sku.Restrictions) {
+		reason := string(pointer.From(restriction.ReasonCode))
+
+		switch pointer.From(restriction.Type) {
+		case skus.ResourceSkuRestrictionsTypeLocation:
+			if containsFold(pointer.From(restriction.Values), loc) {
+				problems = append(problems, fmt.Sprintf("it is restricted in this location for this Subscription (reason: %s)", reason))
+			}
+
+		case skus.ResourceSkuRestrictionsTypeZone:
+			if restriction.RestrictionInfo != nil {
+				for _, zone := range zones.Flatten(restriction.RestrictionInfo.Zones) {
+					restrictedZones[zone] = reason
+				}
+			}
+		}
+	}
+
+	if len(requirements.Zones) > 0 {
+		offeredZones := make([]string, 0)
+		for _, info := range pointer.From(sku.LocationInfo) {
+			if strings.EqualFold(location.Normalize(pointer.From(info.Location)), loc) {
+				offeredZones = append(offeredZones, zones.Flatten(info.Zones)...)
+			}
+		}
+		sort.Strings(offeredZones)
+
+		requestedZones := append([]string{}, requirements.Zones...)
+		sort.Strings(requestedZones)
+
+		for _, zone := range requestedZones {
+			if !containsFold(offeredZones, zone) {
+				if len(offeredZones) == 0 {
+					problems = append(problems, fmt.Sprintf("it is not offered in zone %q since it does not support Availability Zones in this location", zone))
+				} else {
+					problems = append(problems, fmt.Sprintf("it is not offered in zone %q (offered zones: %s)", zone, strings.Join(offeredZones, ", ")))
+				}
+				continue
+			}
+
+			if reason, ok := restrictedZones[zone]; ok {
+				problems = append(problems, fmt.Sprintf("it is restricted in zone %q for this Subscription (reason: %s)", zone, reason))
+			}
+		}
+	}
+
+	capabilities := make(map[string]string)
+	for _, capability := range pointer.From(sku.Capabilities) {
+		capabilities[strings.ToLower(pointer.From(capability.Name))] = pointer.From(capability.Value)
+	}
+	supports := func(name string) bool {
+		return strings.EqualFold(capabilities[strings.ToLower(name)], "True")
+	}
+
+	if requirements.AcceleratedNetworking && !supports("AcceleratedNetworkingEnabled") {
+		problems = append(problems, "it does not support Accelerated Networking")
+	}
+	if requirements.EphemeralOSDisk && !supports("EphemeralOSDiskSupported") {
+		problems = append(problems, "it does not support Ephemeral OS Disks")
+	}
+	if requirements.PremiumStorage && !supports("PremiumIO") {
+		problems = append(problems, "it does not support Premium Storage")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("the Virtual Machine Size %q cannot be used in %q:\n\n* %s", requirements.Size, loc, strings.Join(problems, "\n* "))
+}
+
+func containsFold(input []string, value string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
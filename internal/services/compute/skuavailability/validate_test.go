@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package skuavailability
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+func TestValidateRequirements(t *testing.T) {
+	available := []skus.ResourceSku{
+		{
+			Name: pointer.To("Standard_D8ds_v5"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{Name: pointer.To("AcceleratedNetworkingEnabled"), Value: pointer.To("True")},
+				{Name: pointer.To("EphemeralOSDiskSupported"), Value: pointer.To("True")},
+				{Name: pointer.To("PremiumIO"), Value: pointer.To("True")},
+			},
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+					Zones:    pointer.To([]string{"1", "2", "3"}),
+				},
+			},
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeZone),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					Values:     pointer.To([]string{"westeurope"}),
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Locations: pointer.To([]string{"westeurope"}),
+						Zones:     pointer.To([]string{"3"}),
+					},
+				},
+			},
+		},
+		{
+			Name: pointer.To("Standard_A1_v2"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{Name: pointer.To("AcceleratedNetworkingEnabled"), Value: pointer.To("False")},
+				{Name: pointer.To("EphemeralOSDiskSupported"), Value: pointer.To("False")},
+				{Name: pointer.To("PremiumIO"), Value: pointer.To("False")},
+			},
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+				},
+			},
+		},
+		{
+			Name: pointer.To("Standard_M416ms_v2"),
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+					Zones:    pointer.To([]string{"1"}),
+				},
+			},
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeLocation),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					Values:     pointer.To([]string{"westeurope"}),
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name         string
+		Requirements Requirements
+		// ExpectedErrors is a list of substrings which must be present in the error, empty means no error is expected
+		ExpectedErrors []string
+	}{
+		{
+			Name: "Available",
+			Requirements: Requirements{
+				Size: "Standard_D8ds_v5",
+			},
+		},
+		{
+			Name: "Available Case Insensitively",
+			Requirements: Requirements{
+				Size: "standard_d8ds_v5",
+			},
+		},
+		{
+			Name: "Available With All Capabilities And Zones",
+			Requirements: Requirements{
+				Size:                  "Standard_D8ds_v5",
+				Zones:                 []string{"1", "2"},
+				AcceleratedNetworking: true,
+				EphemeralOSDisk:       true,
+				PremiumStorage:        true,
+			},
+		},
+		{
+			Name: "Not Offered In Location",
+			Requirements: Requirements{
+				Size: "Standard_E96as_v5",
+			},
+			ExpectedErrors: []string{`"Standard_E96as_v5" is not available in "westeurope"`},
+		},
+		{
+			Name: "Restricted In Location",
+			Requirements: Requirements{
+				Size: "Standard_M416ms_v2",
+			},
+			ExpectedErrors: []string{"restricted in this location for this Subscription (reason: NotAvailableForSubscription)"},
+		},
+		{
+			Name: "Restricted In Zone",
+			Requirements: Requirements{
+				Size:  "Standard_D8ds_v5",
+				Zones: []string{"3"},
+			},
+			ExpectedErrors: []string{`restricted in zone "3"`},
+		},
+		{
+			Name: "Not Offered In Zone",
+			Requirements: Requirements{
+				Size:  "Standard_M416ms_v2",
+				Zones: []string{"2"},
+			},
+			ExpectedErrors: []string{
+				`not offered in zone "2" (offered zones: 1)`,
+				"restricted in this location",
+			},
+		},
+		{
+			Name: "No Zone Support",
+			Requirements: Requirements{
+				Size:  "Standard_A1_v2",
+				Zones: []string{"1"},
+			},
+			ExpectedErrors: []string{"does not support Availability Zones"},
+		},
+		{
+			Name: "Missing Capabilities",
+			Requirements: Requirements{
+				Size:                  "Standard_A1_v2",
+				AcceleratedNetworking: true,
+				EphemeralOSDisk:       true,
+				PremiumStorage:        true,
+			},
+			ExpectedErrors: []string{
+				"does not support Accelerated Networking",
+				"does not support Ephemeral OS Disks",
+				"does not support Premium Storage",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateRequirements(available, "westeurope", v.Requirements)
+		if len(v.ExpectedErrors) == 0 {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		for _, expected := range v.ExpectedErrors {
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("expected the error to contain %q but got: %+v", expected, err)
+			}
+		}
+	}
+}
@@ -164,7 +164,7 @@ func resourceSshPublicKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 			PublicKey: utils.String(d.Get("public_key").(string)),
 		}
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
 		payload.Tags = tags.Expand(tagsRaw)
 	}
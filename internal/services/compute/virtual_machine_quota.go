@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/vmquota"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// These CustomizeDiff functions are opted into using the `quota` block within the Provider `features` block, and
+// total the vCPUs added by the resources within the plan so that exceeding the approved quota is surfaced during
+// the plan, rather than part-way through the apply.
+
+func virtualMachineQuotaCustomizeDiff(resourceType string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		return validateVirtualMachineQuota(ctx, d, meta, resourceType, []string{"size", "priority"}, func(get func(string) interface{}) vmquota.Consumption {
+			return vmquota.Consumption{
+				Size:      get("size").(string),
+				Instances: 1,
+				Spot:      get("priority").(string) == string(virtualmachines.VirtualMachinePriorityTypesSpot),
+			}
+		})
+	}
+}
+
+func virtualMachineScaleSetQuotaCustomizeDiff(resourceType string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		return validateVirtualMachineQuota(ctx, d, meta, resourceType, []string{"sku", "instances", "priority"}, func(get func(string) interface{}) vmquota.Consumption {
+			return vmquota.Consumption{
+				Size:      get("sku").(string),
+				Instances: int64(get("instances").(int)),
+				Spot:      get("priority").(string) == string(virtualmachines.VirtualMachinePriorityTypesSpot),
+			}
+		})
+	}
+}
+
+func orchestratedVirtualMachineScaleSetQuotaCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	return validateVirtualMachineQuota(ctx, d, meta, "azurerm_orchestrated_virtual_machine_scale_set", []string{"sku_name", "instances", "priority"}, func(get func(string) interface{}) vmquota.Consumption {
+		consumption := vmquota.Consumption{
+			Instances: int64(get("instances").(int)),
+			Spot:      get("priority").(string) == string(virtualmachines.VirtualMachinePriorityTypesSpot),
+		}
+		// a `sku_name` of `Mix` means multiple Virtual Machine Sizes are used, which are defined elsewhere
+		if skuName := get("sku_name").(string); !strings.EqualFold(skuName, "Mix") {
+			consumption.Size = skuName
+		}
+		return consumption
+	})
+}
+
+func validateVirtualMachineQuota(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}, resourceType string, fields []string, consumptionFunc func(get func(string) interface{}) vmquota.Consumption) error {
+	client := meta.(*clients.Client)
+	if !client.Features.Quota.ValidateDuringPlan {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(append([]string{"location"}, fields...)...) {
+		return nil
+	}
+
+	// values which aren't known until apply can't be totalled
+	for _, field := range append([]string{"name", "resource_group_name", "location"}, fields...) {
+		if !d.NewValueKnown(field) {
+			return nil
+		}
+	}
+
+	old := vmquota.Consumption{}
+	// when the Location changes the existing resource's quota is released in another Location
+	if d.Id() != "" && !d.HasChange("location") {
+		old = consumptionFunc(func(key string) interface{} {
+			v, _ := d.GetChange(key)
+			return v
+		})
+	}
+	new := consumptionFunc(d.Get)
+
+	resourceId := fmt.Sprintf("%s/%s/%s", resourceType, d.Get("resource_group_name").(string), d.Get("name").(string))
+	clients := vmquota.Clients{
+		Skus:            client.Compute.SkusClient,
+		VirtualMachines: client.Compute.VirtualMachinesClient,
+	}
+	return vmquota.Check(ctx, clients, resourceId, client.Account.SubscriptionId, d.Get("location").(string), old, new)
+}
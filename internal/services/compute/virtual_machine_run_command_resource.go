@@ -572,7 +572,7 @@ func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
 				payload.Properties.Source = expandVirtualMachineRunCommandSource(config.Source)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = tags.Expand(config.Tags)
 			}
 
@@ -14,9 +14,11 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryapplicationversions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/applicationsecuritygroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/loadbalancers"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -560,8 +562,11 @@ func virtualMachineScaleSetIPConfigurationSchema() *pluginsdk.Schema {
 				"application_gateway_backend_address_pool_ids": {
 					Type:     pluginsdk.TypeSet,
 					Optional: true,
-					Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-					Set:      pluginsdk.HashString,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.ResourceIDOfType(&networkParse.ApplicationGatewayBackendAddressPoolId{}),
+					},
+					Set: pluginsdk.HashString,
 				},
 
 				"application_security_group_ids": {
@@ -578,15 +583,21 @@ func virtualMachineScaleSetIPConfigurationSchema() *pluginsdk.Schema {
 				"load_balancer_backend_address_pool_ids": {
 					Type:     pluginsdk.TypeSet,
 					Optional: true,
-					Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-					Set:      pluginsdk.HashString,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.ResourceIDOfType(&loadbalancers.LoadBalancerBackendAddressPoolId{}),
+					},
+					Set: pluginsdk.HashString,
 				},
 
 				"load_balancer_inbound_nat_rules_ids": {
 					Type:     pluginsdk.TypeSet,
 					Optional: true,
-					Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-					Set:      pluginsdk.HashString,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.ResourceIDOfType(&loadbalancers.InboundNatRuleId{}),
+					},
+					Set: pluginsdk.HashString,
 				},
 
 				"primary": {
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/skuavailability"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// These CustomizeDiff functions are opted into using the `resource_sku` block within the Provider `features` block,
+// and surface a Virtual Machine Size which isn't offered in the Location/Zone (or doesn't support the capabilities
+// being used) during the plan, rather than part-way through the apply.
+
+func virtualMachineSkuAvailabilityCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	return validateVirtualMachineSkuAvailability(ctx, d, meta, []string{"size", "zone", "os_disk"}, func() skuavailability.Requirements {
+		requirements := skuavailability.Requirements{
+			Size: d.Get("size").(string),
+		}
+		if zone := d.Get("zone").(string); zone != "" {
+			requirements.Zones = []string{zone}
+		}
+		requirements.EphemeralOSDisk, requirements.PremiumStorage = virtualMachineOSDiskSkuRequirements(d)
+		return requirements
+	})
+}
+
+func virtualMachineScaleSetSkuAvailabilityCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	return validateVirtualMachineSkuAvailability(ctx, d, meta, []string{"sku", "zones", "os_disk", "network_interface"}, func() skuavailability.Requirements {
+		requirements := skuavailability.Requirements{
+			Size:                  d.Get("sku").(string),
+			Zones:                 zones.ExpandUntyped(d.Get("zones").(*pluginsdk.Set).List()),
+			AcceleratedNetworking: virtualMachineScaleSetUsesAcceleratedNetworking(d),
+		}
+		requirements.EphemeralOSDisk, requirements.PremiumStorage = virtualMachineOSDiskSkuRequirements(d)
+		return requirements
+	})
+}
+
+func orchestratedVirtualMachineScaleSetSkuAvailabilityCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	return validateVirtualMachineSkuAvailability(ctx, d, meta, []string{"sku_name", "zones", "os_disk", "network_interface"}, func() skuavailability.Requirements {
+		requirements := skuavailability.Requirements{
+			Zones:                 zones.ExpandUntyped(d.Get("zones").(*pluginsdk.Set).List()),
+			AcceleratedNetworking: virtualMachineScaleSetUsesAcceleratedNetworking(d),
+		}
+		// a `sku_name` of `Mix` means multiple Virtual Machine Sizes are used, which are defined elsewhere
+		if skuName := d.Get("sku_name").(string); !strings.EqualFold(skuName, "Mix") {
+			requirements.Size = skuName
+		}
+		requirements.EphemeralOSDisk, requirements.PremiumStorage = virtualMachineOSDiskSkuRequirements(d)
+		return requirements
+	})
+}
+
+func validateVirtualMachineSkuAvailability(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}, fields []string, requirementsFunc func() skuavailability.Requirements) error {
+	client := meta.(*clients.Client)
+	if !client.Features.ResourceSku.ValidateAvailabilityDuringPlan {
+		return nil
+	}
+
+	// existing resources are only validated when the Virtual Machine Size (or how it's used) changes
+	if d.Id() != "" && !d.HasChanges(fields...) {
+		return nil
+	}
+
+	// values which aren't known until apply (e.g. a `location` from another resource) can't be validated
+	for _, field := range append([]string{"location"}, fields...) {
+		if !d.NewValueKnown(field) {
+			return nil
+		}
+	}
+
+	requirements := requirementsFunc()
+	if requirements.Size == "" {
+		return nil
+	}
+
+	return skuavailability.ValidateVirtualMachineSize(ctx, client.Compute.SkusClient, client.Account.SubscriptionId, d.Get("location").(string), requirements)
+}
+
+func virtualMachineOSDiskSkuRequirements(d *pluginsdk.ResourceDiff) (ephemeral bool, premium bool) {
+	osDisks := d.Get("os_disk").([]interface{})
+	if len(osDisks) == 0 || osDisks[0] == nil {
+		return false, false
+	}
+	osDisk := osDisks[0].(map[string]interface{})
+
+	if v, ok := osDisk["diff_disk_settings"].([]interface{}); ok && len(v) > 0 {
+		ephemeral = true
+	}
+
+	storageAccountType, _ := osDisk["storage_account_type"].(string)
+	premium = strings.EqualFold(storageAccountType, string(virtualmachines.StorageAccountTypesPremiumLRS)) ||
+		strings.EqualFold(storageAccountType, string(virtualmachines.StorageAccountTypesPremiumZRS))
+
+	return ephemeral, premium
+}
+
+func virtualMachineScaleSetUsesAcceleratedNetworking(d *pluginsdk.ResourceDiff) bool {
+	for _, v := range d.Get("network_interface").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		if enabled, ok := v.(map[string]interface{})["enable_accelerated_networking"].(bool); ok && enabled {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmquota
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/quota"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/skuavailability"
+)
+
+const (
+	// totalRegionalVCPUs is the name of the quota covering all (non-Spot) vCPUs within a Location
+	totalRegionalVCPUs = "cores"
+
+	// spotVCPUs is the name of the quota covering all Spot vCPUs within a Location
+	spotVCPUs = "lowPriorityCores"
+)
+
+// Consumption describes the Virtual Machines a resource deploys at a single point in time
+type Consumption struct {
+	// Size is the name of the Virtual Machine Size, for example `Standard_D8ds_v5`
+	Size string
+
+	Instances int64
+
+	// Spot is whether these are Spot Virtual Machines, which are counted against a separate quota
+	Spot bool
+}
+
+type Clients struct {
+	Skus            *skus.SkusClient
+	VirtualMachines *virtualmachines.VirtualMachinesClient
+}
+
+// Check confirms that the vCPUs consumed by changing from `old` to `new` (together with the other resources in the
+// plan) fit within the Total Regional and per-Family vCPU quotas for the Subscription within the Location.
+func Check(ctx context.Context, clients Clients, resourceId, subscriptionId, location string, old, new Consumption) error {
+	lookup := func(size string) (*skus.ResourceSku, error) {
+		return skuavailability.VirtualMachineSku(ctx, clients.Skus, subscriptionId, location, size)
+	}
+	amounts, err := amountsForChange(lookup, old, new)
+	if err != nil {
+		return err
+	}
+
+	change := quota.Change{
+		ResourceId: resourceId,
+		Scope: quota.Scope{
+			SubscriptionId: subscriptionId,
+			Location:       location,
+			Provider:       "Microsoft.Compute",
+		},
+		Amounts: amounts,
+	}
+
+	return quota.Check(ctx, change, func(ctx context.Context) ([]quota.Usage, error) {
+		resp, err := sdkhacks.NewUsagesClient(clients.VirtualMachines).List(ctx, commonids.NewSubscriptionID(subscriptionId), location)
+		if err != nil {
+			return nil, err
+		}
+
+		usages := make([]quota.Usage, 0)
+		for _, v := range pointer.From(resp.Model) {
+			usages = append(usages, quota.Usage{
+				Name:         pointer.From(v.Name.Value),
+				DisplayName:  pointer.From(v.Name.LocalizedValue),
+				CurrentValue: v.CurrentValue,
+				Limit:        v.Limit,
+			})
+		}
+		return usages, nil
+	})
+}
+
+func amountsForChange(lookup func(size string) (*skus.ResourceSku, error), old, new Consumption) (map[string]int64, error) {
+	amounts := make(map[string]int64)
+
+	for _, v := range []struct {
+		consumption Consumption
+		sign        int64
+	}{
+		{consumption: old, sign: -1},
+		{consumption: new, sign: 1},
+	} {
+		if v.consumption.Size == "" || v.consumption.Instances == 0 {
+			continue
+		}
+
+		sku, err := lookup(v.consumption.Size)
+		if err != nil {
+			return nil, err
+		}
+		// Sizes which aren't offered are surfaced by the `resource_sku` feature, or otherwise by the API
+		if sku == nil {
+			continue
+		}
+
+		vCPUs, err := vCPUsForSku(*sku)
+		if err != nil {
+			return nil, err
+		}
+
+		total := v.sign * vCPUs * v.consumption.Instances
+		if v.consumption.Spot {
+			amounts[spotVCPUs] += total
+			continue
+		}
+
+		amounts[totalRegionalVCPUs] += total
+		if family := pointer.From(sku.Family); family != "" {
+			amounts[family] += total
+		}
+	}
+
+	return amounts, nil
+}
+
+func vCPUsForSku(sku skus.ResourceSku) (int64, error) {
+	for _, capability := range pointer.From(sku.Capabilities) {
+		if !strings.EqualFold(pointer.From(capability.Name), "vCPUs") {
+			continue
+		}
+
+		v, err := strconv.ParseInt(pointer.From(capability.Value), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing the vCPUs capability %q for the Virtual Machine Size %q: %+v", pointer.From(capability.Value), pointer.From(sku.Name), err)
+		}
+		return v, nil
+	}
+
+	return 0, nil
+}
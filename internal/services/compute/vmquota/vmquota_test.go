@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vmquota
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+func TestAmountsForChange(t *testing.T) {
+	available := map[string]skus.ResourceSku{
+		"Standard_D8ds_v5": {
+			Name:   pointer.To("Standard_D8ds_v5"),
+			Family: pointer.To("standardDDSv5Family"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{Name: pointer.To("vCPUs"), Value: pointer.To("8")},
+			},
+		},
+		"Standard_F2s_v2": {
+			Name:   pointer.To("Standard_F2s_v2"),
+			Family: pointer.To("standardFSv2Family"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{Name: pointer.To("vCPUs"), Value: pointer.To("2")},
+			},
+		},
+	}
+	lookup := func(size string) (*skus.ResourceSku, error) {
+		if v, ok := available[size]; ok {
+			return &v, nil
+		}
+		return nil, nil
+	}
+
+	testData := []struct {
+		Name     string
+		Old      Consumption
+		New      Consumption
+		Expected map[string]int64
+	}{
+		{
+			Name: "Create",
+			New:  Consumption{Size: "Standard_D8ds_v5", Instances: 3},
+			Expected: map[string]int64{
+				"cores":               24,
+				"standardDDSv5Family": 24,
+			},
+		},
+		{
+			Name: "Scale Out",
+			Old:  Consumption{Size: "Standard_F2s_v2", Instances: 2},
+			New:  Consumption{Size: "Standard_F2s_v2", Instances: 5},
+			Expected: map[string]int64{
+				"cores":              6,
+				"standardFSv2Family": 6,
+			},
+		},
+		{
+			Name: "Resize To Another Family",
+			Old:  Consumption{Size: "Standard_F2s_v2", Instances: 1},
+			New:  Consumption{Size: "Standard_D8ds_v5", Instances: 1},
+			Expected: map[string]int64{
+				"cores":               6,
+				"standardFSv2Family":  -2,
+				"standardDDSv5Family": 8,
+			},
+		},
+		{
+			Name: "Spot",
+			New:  Consumption{Size: "Standard_D8ds_v5", Instances: 2, Spot: true},
+			Expected: map[string]int64{
+				"lowPriorityCores": 16,
+			},
+		},
+		{
+			Name:     "Unknown Size",
+			New:      Consumption{Size: "Standard_Unknown", Instances: 2},
+			Expected: map[string]int64{},
+		},
+		{
+			Name:     "No Instances",
+			New:      Consumption{Size: "Standard_D8ds_v5", Instances: 0},
+			Expected: map[string]int64{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := amountsForChange(lookup, v.Old, v.New)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -263,7 +263,7 @@ func resourceConfidentialLedgerUpdate(d *pluginsdk.ResourceData, meta interface{
 		ledger.Properties.CertBasedSecurityPrincipals = certBasedUsers
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		ledger.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
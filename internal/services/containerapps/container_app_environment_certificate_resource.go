@@ -257,7 +257,7 @@ func (r ContainerAppEnvironmentCertificateResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				patch := certificates.CertificatePatch{
 					Tags: tags.Expand(cert.Tags),
 				}
@@ -397,7 +397,7 @@ func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("reading %s: %+v", *id, err)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Model.Tags = tags.Expand(state.Tags)
 			}
 
@@ -500,7 +500,7 @@ func (r ContainerAppJobResource) Update() sdk.ResourceFunc {
 				model.Properties.WorkloadProfileName = pointer.To(state.WorkloadProfileName)
 			}
 
-			if d.HasChange("tags") {
+			if d.HasChanges("tags", "tags_all") {
 				model.Tags = tags.Expand(state.Tags)
 			}
 
@@ -410,7 +410,7 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 				model.Properties.WorkloadProfileName = pointer.To(state.WorkloadProfileName)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = tags.Expand(state.Tags)
 			}
 
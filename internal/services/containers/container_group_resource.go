@@ -848,7 +848,9 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 				return fmt.Errorf(`parsing subnet id %q: %v`, item.Id, err)
 			}
 
-			locks.ByID(subnet.ID())
+			if err := locks.ByIDWithContext(ctx, subnet.ID()); err != nil {
+				return err
+			}
 			defer locks.UnlockByID(subnet.ID())
 		}
 	}
@@ -889,7 +891,7 @@ func resourceContainerGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		model.Identity = expandedIdentity
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		model.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -1090,7 +1092,9 @@ func resourceContainerGroupDelete(d *pluginsdk.ResourceData, meta interface{}) e
 					return fmt.Errorf(`parsing subnet id %q: %v`, item.Id, err)
 				}
 
-				locks.ByID(subnet.ID())
+				if err := locks.ByIDWithContext(ctx, subnet.ID()); err != nil {
+					return err
+				}
 				defer locks.UnlockByID(subnet.ID())
 			}
 		}
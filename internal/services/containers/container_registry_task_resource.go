@@ -942,7 +942,7 @@ func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("timeout_in_seconds") {
 				existing.Model.Properties.Timeout = pointer.To(model.TimeoutInSec)
 			}
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Model.Tags = &model.Tags
 			}
 
@@ -23,13 +23,16 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/snapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/publicipprefixes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/subnets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/skuavailability"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/vmquota"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
@@ -71,10 +74,148 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("upgrade_settings.0.drain_timeout_in_minutes", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old != 0 && new == 0
 			}),
+			kubernetesClusterNodePoolPlanValidationCustomizeDiff,
 		),
 	}
 }
 
+// kubernetesClusterNodePoolPlanValidationCustomizeDiff runs the checks which are opted into using the `resource_sku`
+// and `quota` blocks within the Provider `features` block. Both need the Location of the Kubernetes Cluster, which is
+// only retrieved once.
+func kubernetesClusterNodePoolPlanValidationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	validateSkuAvailability := client.Features.ResourceSku.ValidateAvailabilityDuringPlan && kubernetesClusterNodePoolShouldValidateSkuAvailability(d)
+	validateQuota := client.Features.Quota.ValidateDuringPlan && kubernetesClusterNodePoolShouldValidateQuota(d)
+	if !validateSkuAvailability && !validateQuota {
+		return nil
+	}
+
+	clusterId, err := commonids.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	location, err := kubernetesClusterNodePoolLocation(ctx, client.Containers.KubernetesClustersClient, *clusterId)
+	if err != nil || location == nil {
+		return err
+	}
+
+	if validateSkuAvailability {
+		if err := kubernetesClusterNodePoolValidateSkuAvailability(ctx, d, client, *clusterId, *location); err != nil {
+			return err
+		}
+	}
+
+	if validateQuota {
+		return kubernetesClusterNodePoolValidateQuota(ctx, d, client, *clusterId, *location)
+	}
+
+	return nil
+}
+
+func kubernetesClusterNodePoolShouldValidateSkuAvailability(d *pluginsdk.ResourceDiff) bool {
+	fields := []string{"kubernetes_cluster_id", "vm_size", "zones", "os_disk_type"}
+	if d.Id() != "" && !d.HasChanges(fields...) {
+		return false
+	}
+	for _, field := range fields {
+		if !d.NewValueKnown(field) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// kubernetesClusterNodePoolValidateSkuAvailability surfaces a `vm_size` which isn't offered in the Kubernetes
+// Cluster's Location/Zones during the plan
+func kubernetesClusterNodePoolValidateSkuAvailability(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client, clusterId commonids.KubernetesClusterId, location string) error {
+	requirements := skuavailability.Requirements{
+		Size:            d.Get("vm_size").(string),
+		Zones:           zones.ExpandUntyped(d.Get("zones").(*schema.Set).List()),
+		EphemeralOSDisk: d.Get("os_disk_type").(string) == string(agentpools.OSDiskTypeEphemeral),
+	}
+
+	return skuavailability.ValidateVirtualMachineSize(ctx, client.Compute.SkusClient, clusterId.SubscriptionId, location, requirements)
+}
+
+func kubernetesClusterNodePoolAutoScalingField() string {
+	if features.FourPointOhBeta() {
+		return "auto_scaling_enabled"
+	}
+	return "enable_auto_scaling"
+}
+
+func kubernetesClusterNodePoolShouldValidateQuota(d *pluginsdk.ResourceDiff) bool {
+	autoScalingField := kubernetesClusterNodePoolAutoScalingField()
+
+	fields := []string{"kubernetes_cluster_id", "name", "vm_size", "priority", "node_count", "min_count", autoScalingField}
+	if d.Id() != "" && !d.HasChanges(fields...) {
+		return false
+	}
+	for _, field := range []string{"kubernetes_cluster_id", "name", "vm_size", "priority", autoScalingField} {
+		if !d.NewValueKnown(field) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// kubernetesClusterNodePoolValidateQuota includes the vCPUs added by this Node Pool in the total compared against the
+// approved quota during the plan
+func kubernetesClusterNodePoolValidateQuota(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client, clusterId commonids.KubernetesClusterId, location string) error {
+	autoScalingField := kubernetesClusterNodePoolAutoScalingField()
+
+	consumption := func(get func(string) interface{}) vmquota.Consumption {
+		count := get("node_count").(int)
+		// when auto-scaling the Node Pool starts with `min_count` nodes, unless `node_count` is specified
+		if get(autoScalingField).(bool) && count == 0 {
+			count = get("min_count").(int)
+		}
+
+		return vmquota.Consumption{
+			Size:      get("vm_size").(string),
+			Instances: int64(count),
+			Spot:      get("priority").(string) == string(agentpools.ScaleSetPrioritySpot),
+		}
+	}
+
+	old := vmquota.Consumption{}
+	if d.Id() != "" {
+		old = consumption(func(key string) interface{} {
+			v, _ := d.GetChange(key)
+			return v
+		})
+	}
+	// `node_count` is Computed, so when it's not known this is treated as 0 and `min_count` is used instead
+	new := consumption(d.Get)
+
+	resourceId := agentpools.NewAgentPoolID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ManagedClusterName, d.Get("name").(string)).ID()
+	clients := vmquota.Clients{
+		Skus:            client.Compute.SkusClient,
+		VirtualMachines: client.Compute.VirtualMachinesClient,
+	}
+	return vmquota.Check(ctx, clients, resourceId, clusterId.SubscriptionId, location, old, new)
+}
+
+// kubernetesClusterNodePoolLocation returns the Location of the Kubernetes Cluster which a Node Pool is deployed into,
+// or nil when the Kubernetes Cluster doesn't exist yet (e.g. it's being created within the same plan)
+func kubernetesClusterNodePoolLocation(ctx context.Context, client *managedclusters.ManagedClustersClient, id commonids.KubernetesClusterId) (*string, error) {
+	cluster, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(cluster.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if cluster.Model == nil {
+		return nil, nil
+	}
+
+	return pointer.To(cluster.Model.Location), nil
+}
+
 func resourceKubernetesClusterNodePoolSchema() map[string]*pluginsdk.Schema {
 	s := map[string]*pluginsdk.Schema{
 		"name": {
@@ -228,6 +369,7 @@ func resourceKubernetesClusterNodePoolSchema() map[string]*pluginsdk.Schema {
 				}
 				return []string{"node_public_ip_enabled"}
 			}(),
+			ValidateFunc: validation.ResourceIDOfType(&publicipprefixes.PublicIPPrefixId{}),
 		},
 
 		// Node Taints control the behaviour of the Node Pool, as such they should not be computed and
@@ -457,10 +599,14 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 			return err
 		}
 
-		locks.ByName(subnetID.VirtualNetworkName, network.VirtualNetworkResourceName)
+		if err := locks.ByNameWithContext(ctx, subnetID.VirtualNetworkName, network.VirtualNetworkResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockByName(subnetID.VirtualNetworkName, network.VirtualNetworkResourceName)
 
-		locks.ByName(subnetID.SubnetName, network.SubnetResourceName)
+		if err := locks.ByNameWithContext(ctx, subnetID.SubnetName, network.SubnetResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockByName(subnetID.SubnetName, network.SubnetResourceName)
 	}
 
@@ -835,7 +981,7 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		props.Tags = tags.Expand(t)
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/maintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
 	dnsValidate "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/publicipprefixes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/privatezones"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -1124,7 +1125,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 										ConflictsWith: []string{"network_profile.0.load_balancer_profile.0.managed_outbound_ip_count", "network_profile.0.load_balancer_profile.0.outbound_ip_address_ids"},
 										Elem: &pluginsdk.Schema{
 											Type:         pluginsdk.TypeString,
-											ValidateFunc: azure.ValidateResourceID,
+											ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&publicipprefixes.PublicIPPrefixId{})),
 										},
 									},
 									"outbound_ip_address_ids": {
@@ -1133,7 +1134,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 										ConflictsWith: []string{"network_profile.0.load_balancer_profile.0.managed_outbound_ip_count", "network_profile.0.load_balancer_profile.0.outbound_ip_prefix_ids"},
 										Elem: &pluginsdk.Schema{
 											Type:         pluginsdk.TypeString,
-											ValidateFunc: azure.ValidateResourceID,
+											ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.PublicIPAddressId{})),
 										},
 									},
 									"effective_outbound_ips": {
@@ -1544,7 +1545,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			ConflictsWith: []string{"network_profile.0.load_balancer_profile.0.managed_outbound_ip_count", "network_profile.0.load_balancer_profile.0.outbound_ip_address_ids"},
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&publicipprefixes.PublicIPPrefixId{})),
 			},
 		}
 		resource.Schema["network_profile"].Elem.(*pluginsdk.Resource).Schema["outbound_ip_address_ids"] = &pluginsdk.Schema{
@@ -1555,7 +1556,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			ConflictsWith: []string{"network_profile.0.load_balancer_profile.0.managed_outbound_ip_count", "network_profile.0.load_balancer_profile.0.outbound_ip_prefix_ids"},
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.PublicIPAddressId{})),
 			},
 		}
 		resource.Schema["azure_active_directory_role_based_access_control"] = &pluginsdk.Schema{
@@ -2328,7 +2329,7 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateCluster = true
 		t := d.Get("tags").(map[string]interface{})
 		existing.Model.Tags = tags.Expand(t)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/fleetupdatestrategies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/updateruns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -132,6 +133,7 @@ func (r KubernetesFleetUpdateRunResource) Arguments() map[string]*pluginsdk.Sche
 			Optional:      true,
 			Type:          pluginsdk.TypeString,
 			ConflictsWith: []string{"stage"},
+			ValidateFunc:  validation.ResourceIDOfType(&fleetupdatestrategies.UpdateStrategyId{}),
 		},
 
 		"stage": {
@@ -32,7 +32,7 @@ type CosmosDbPostgreSQLClusterModel struct {
 	CoordinatorStorageQuotaInMb      int64               `tfschema:"coordinator_storage_quota_in_mb"`
 	CoordinatorVCoreCount            int64               `tfschema:"coordinator_vcore_count"`
 	HaEnabled                        bool                `tfschema:"ha_enabled"`
-	ShardsOnCoordinatorEnabled       bool                `tfschema:"shards_on_coordinator_enabled"`
+	ShardsOnCoordinatorEnabled       *bool               `tfschema:"shards_on_coordinator_enabled,explicitlyConfigured"`
 	SourceLocation                   string              `tfschema:"source_location"`
 	SourceResourceId                 string              `tfschema:"source_resource_id"`
 	MaintenanceWindow                []MaintenanceWindow `tfschema:"maintenance_window"`
@@ -411,11 +411,8 @@ func (r CosmosDbPostgreSQLClusterResource) Create() sdk.ResourceFunc {
 
 			// If `shards_on_coordinator_enabled` isn't set, API would set it to `true` when `node_count` is `0`.
 			// If `shards_on_coordinator_enabled` isn't set, API would set it to `false` when `node_count` is greater than or equal to `2`.
-			// As `shards_on_coordinator_enabled` is `bool` and it's always set to `false` as zero value when it isn't set, so we cannot use `model.ShardsOnCoordinatorEnabled` to check if this property is set in tf config.
-			// nolint staticcheck
-			if v, ok := metadata.ResourceData.GetOkExists("shards_on_coordinator_enabled"); ok {
-				parameters.Properties.EnableShardsOnCoordinator = utils.Bool(v.(bool))
-			}
+			// As such this is only sent when it's been explicitly configured, including when it's set to `false`.
+			parameters.Properties.EnableShardsOnCoordinator = model.ShardsOnCoordinatorEnabled
 
 			if v := model.Tags; v != nil {
 				parameters.Tags = &v
@@ -520,14 +517,14 @@ func (r CosmosDbPostgreSQLClusterResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("shards_on_coordinator_enabled") {
-				parameters.Properties.EnableShardsOnCoordinator = &model.ShardsOnCoordinatorEnabled
+				parameters.Properties.EnableShardsOnCoordinator = model.ShardsOnCoordinatorEnabled
 			}
 
 			if metadata.ResourceData.HasChange("sql_version") {
 				parameters.Properties.PostgresqlVersion = &model.SqlVersion
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = &model.Tags
 			}
 
@@ -587,7 +584,7 @@ func (r CosmosDbPostgreSQLClusterResource) Read() sdk.ResourceFunc {
 				state.NodeServerEdition = pointer.From(props.NodeServerEdition)
 				state.NodeStorageQuotaInMb = pointer.From(props.NodeStorageQuotaInMb)
 				state.NodeVCores = pointer.From(props.NodeVCores)
-				state.ShardsOnCoordinatorEnabled = pointer.From(props.EnableShardsOnCoordinator)
+				state.ShardsOnCoordinatorEnabled = pointer.To(pointer.From(props.EnableShardsOnCoordinator))
 				state.CitusVersion = pointer.From(props.CitusVersion)
 				state.PreferredPrimaryZone = pointer.From(props.PreferredPrimaryZone)
 				state.SqlVersion = pointer.From(props.PostgresqlVersion)
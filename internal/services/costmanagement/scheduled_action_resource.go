@@ -42,7 +42,7 @@ func (r CostManagementScheduledActionResource) Arguments() map[string]*pluginsdk
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&views.ScopedViewId{})),
 		},
 
 		"email_subject": {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -172,7 +173,7 @@ func (r DashboardGrafanaResource) Arguments() map[string]*pluginsdk.Schema {
 					"resource_id": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&azuremonitorworkspaces.AccountId{})),
 					},
 				},
 			},
@@ -374,7 +375,7 @@ func (r DashboardGrafanaResource) Update() sdk.ResourceFunc {
 				properties.Properties.PublicNetworkAccess = &publicNetworkAccess
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
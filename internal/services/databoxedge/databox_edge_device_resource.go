@@ -251,7 +251,7 @@ func (r EdgeDeviceResource) Update() sdk.ResourceFunc {
 			}
 
 			parameters := devices.DataBoxEdgeDevicePatch{}
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Tags = &metaModel.Tags
 			}
 
@@ -140,7 +140,7 @@ func (r AccessConnectorResource) Update() sdk.ResourceFunc {
 				existing.Model.Identity = identityValue
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Model.Tags = &state.Tags
 			}
 
@@ -137,6 +137,7 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"default_storage_firewall_enabled"},
+				ValidateFunc: validation.ResourceIDOfType(&accessconnector.AccessConnectorId{}),
 			},
 
 			"network_security_group_rules_required": {
@@ -205,7 +206,7 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 						"public_subnet_network_security_group_association_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.SubnetId{})),
 							AtLeastOneOf: workspaceCustomParametersString(),
 						},
 
@@ -219,7 +220,7 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 						"private_subnet_network_security_group_association_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.SubnetId{})),
 							AtLeastOneOf: workspaceCustomParametersString(),
 						},
 
@@ -650,7 +651,7 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 	// this will cause the updated tags to be propagated to all of the connected
 	// workspace resources.
 	// TODO: can be removed once https://github.com/Azure/azure-sdk-for-go/issues/14571 is fixed
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		workspaceUpdate := workspaces.WorkspaceUpdate{
 			Tags: expandedTags,
 		}
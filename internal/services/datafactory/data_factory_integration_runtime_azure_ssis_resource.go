@@ -183,7 +183,7 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							ExactlyOneOf: []string{"vnet_integration.0.vnet_id", "vnet_integration.0.subnet_id"},
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.VirtualNetworkId{})),
 						},
 						"subnet_id": {
 							Type:         pluginsdk.TypeString,
@@ -146,7 +146,7 @@ func resourceDataShareAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	props := account.AccountUpdateParameters{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		props.Tags = helperTags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
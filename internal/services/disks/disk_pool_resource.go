@@ -234,7 +234,7 @@ func (r DiskPoolResource) Update() sdk.ResourceFunc {
 				sku := expandDisksPoolSku(m.Sku)
 				patch.Sku = &sku
 			}
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				patch.Tags = tags.Expand(m.Tags)
 			}
 
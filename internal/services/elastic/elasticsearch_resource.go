@@ -321,7 +321,7 @@ func resourceElasticsearchUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		client := meta.(*clients.Client).Elastic.MonitorClient
 		body := monitorsresource.ElasticMonitorResourceUpdateParameters{
 			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
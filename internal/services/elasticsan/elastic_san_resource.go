@@ -324,7 +324,7 @@ func (r ElasticSANResource) Update() sdk.ResourceFunc {
 				payload.Properties.ExtendedCapacitySizeTiB = pointer.To(config.ExtendedSizeInTiB)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = tags.Expand(config.Tags)
 			}
 
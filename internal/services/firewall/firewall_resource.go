@@ -356,10 +356,14 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.ByName(id.AzureFirewallName, AzureFirewallResourceName)
 	defer locks.UnlockByName(id.AzureFirewallName, AzureFirewallResourceName)
 
-	locks.MultipleByName(vnetToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(vnetToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetToLock, SubnetResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, subnetToLock, SubnetResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockMultipleByName(subnetToLock, SubnetResourceName)
 
 	if !d.IsNewResource() {
@@ -548,10 +552,14 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		locks.ByName(id.AzureFirewallName, AzureFirewallResourceName)
 		defer locks.UnlockByName(id.AzureFirewallName, AzureFirewallResourceName)
 
-		locks.MultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
+		if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNamesToLock, VirtualNetworkResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
 
-		locks.MultipleByName(&subnetNamesToLock, SubnetResourceName)
+		if err := locks.MultipleByNameWithContext(ctx, &subnetNamesToLock, SubnetResourceName); err != nil {
+			return err
+		}
 		defer locks.UnlockMultipleByName(&subnetNamesToLock, SubnetResourceName)
 
 		// todo see if this is still needed this way
@@ -4,6 +4,7 @@
 package frontdoor
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2020-05-01/frontdoors"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -43,8 +44,9 @@ func schemaCustomHttpsConfiguration() map[string]*pluginsdk.Schema {
 			Optional: true,
 		},
 		"azure_key_vault_certificate_vault_id": {
-			Type:     pluginsdk.TypeString,
-			Optional: true,
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.ResourceIDOfType(&commonids.KeyVaultId{}),
 		},
 	}
 }
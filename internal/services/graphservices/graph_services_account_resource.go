@@ -249,7 +249,7 @@ func (r AccountResource) Update() sdk.ResourceFunc {
 			}
 
 			payload := graphservicesprods.TagUpdate{}
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = tags.Expand(config.Tags)
 			}
 
@@ -36,7 +36,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 			return err
 		}
 
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "tags_all") {
 			payload := clusters.ClusterPatchParameters{
 				Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 			}
@@ -143,7 +143,7 @@ func (a ArcPrivateLinkScopeResource) Update() sdk.ResourceFunc {
 				properties.Properties.PublicNetworkAccess = &publicNetwork
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
@@ -223,7 +223,7 @@ func resourceIotCentralAppUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		existing.Model.Properties.Template = utils.String(d.Get("template").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		existing.Model.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -255,7 +255,7 @@ func (r IotHubDeviceUpdateInstanceResource) Update() sdk.ResourceFunc {
 				existing.Properties.EnableDiagnostics = &model.DiagnosticEnabled
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Tags = &model.Tags
 			}
 
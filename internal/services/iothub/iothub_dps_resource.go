@@ -364,7 +364,7 @@ func resourceIotHubDPSUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		iotdps.Sku = expandIoTHubDPSSku(d)
 	}
 
-	if d.HasChanges("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		iotdps.Tags = expandTags(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -164,24 +165,13 @@ func resourceKeyVaultAccessPolicyCreate(d *pluginsdk.ResourceData, meta interfac
 	if _, err = client.UpdateAccessPolicy(ctx, updateId, parameters); err != nil {
 		return fmt.Errorf("creating Access Policy (Object ID %q / Application ID %q) within %s: %+v", objectId, applicationId, *keyVaultId, err)
 	}
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		return fmt.Errorf("internal-error: context had no deadline")
-	}
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending:                   []string{"notfound", "vaultnotfound"},
-		Target:                    []string{"found"},
-		Refresh:                   accessPolicyRefreshFunc(ctx, client, *keyVaultId, objectId, applicationId),
-		Delay:                     5 * time.Second,
-		ContinuousTargetOccurence: 3,
-		Timeout:                   time.Until(deadline),
-	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("creating Access Policy (Object ID: %q) within %s: %+v", objectId, keyVaultId, err)
-	}
 
 	d.SetId(id.ID())
-	return nil
+
+	// newly added Access Policies aren't always returned immediately
+	return sdk.ReadUntilConsistent(ctx, d, accessPolicyConsistencyCheck(), func() error {
+		return resourceKeyVaultAccessPolicyRead(d, meta)
+	})
 }
 
 func resourceKeyVaultAccessPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -238,23 +228,11 @@ func resourceKeyVaultAccessPolicyUpdate(d *pluginsdk.ResourceData, meta interfac
 	if _, err = client.UpdateAccessPolicy(ctx, updateId, parameters); err != nil {
 		return fmt.Errorf("updating Access Policy (Object ID %q / Application ID %q) for %s: %+v", id.ObjectID(), id.ApplicationId(), keyVaultId, err)
 	}
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		return fmt.Errorf("internal-error: context had no deadline")
-	}
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending:                   []string{"notfound", "vaultnotfound"},
-		Target:                    []string{"found"},
-		Refresh:                   accessPolicyRefreshFunc(ctx, client, keyVaultId, id.ObjectID(), id.ApplicationId()),
-		Delay:                     5 * time.Second,
-		ContinuousTargetOccurence: 3,
-		Timeout:                   time.Until(deadline),
-	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("failed waiting for update of Access Policy (Object ID: %q) for %s: %+v", id.ObjectID(), keyVaultId, err)
-	}
 
-	return nil
+	// the updated permissions aren't always returned immediately
+	return sdk.ReadUntilConsistent(ctx, d, accessPolicyConsistencyCheck(), func() error {
+		return resourceKeyVaultAccessPolicyRead(d, meta)
+	})
 }
 
 func resourceKeyVaultAccessPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -405,6 +383,19 @@ func findKeyVaultAccessPolicy(policies *[]vaults.AccessPolicyEntry, objectId str
 	return nil
 }
 
+func accessPolicyConsistencyCheck() sdk.ConsistencyCheck {
+	return sdk.ConsistencyCheck{
+		Fields: []string{
+			"object_id",
+			"certificate_permissions",
+			"key_permissions",
+			"secret_permissions",
+			"storage_permissions",
+		},
+		ContinuousTargetOccurence: 3,
+	}
+}
+
 func accessPolicyRefreshFunc(ctx context.Context, client *vaults.VaultsClient, keyVaultId commonids.KeyVaultId, objectId string, applicationId string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Checking for completion of Access Policy create/update")
@@ -563,7 +563,7 @@ func resourceKustoClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		model.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -594,7 +594,7 @@ func (r LabServiceLabResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				props.Tags = &model.Tags
 			}
 
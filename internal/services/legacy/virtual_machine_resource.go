@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -131,6 +132,7 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 					return strings.ToLower(id.(string))
 				},
 				ConflictsWith: []string{"zones"},
+				ValidateFunc:  validation.ResourceIDOfType(&commonids.AvailabilitySetId{}),
 			},
 
 			"proximity_placement_group_id": {
@@ -143,6 +145,7 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 				//
 				// todo can be removed when https://github.com/Azure/azure-sdk-for-go/issues/5699 is fixed
 				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validation.ResourceIDOfType(&proximityplacementgroups.ProximityPlacementGroupId{}),
 			},
 
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
@@ -244,6 +247,7 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 							ForceNew:      true,
 							Computed:      true,
 							ConflictsWith: []string{"storage_os_disk.0.vhd_uri"},
+							ValidateFunc:  validation.ResourceIDOfType(&commonids.ManagedDiskId{}),
 						},
 
 						"managed_disk_type": {
@@ -318,6 +322,7 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 							Optional:         true,
 							Computed:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc:     validation.ResourceIDOfType(&commonids.ManagedDiskId{}),
 						},
 
 						"managed_disk_type": {
@@ -565,8 +570,9 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"source_vault_id": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.ResourceIDOfType(&commonids.KeyVaultId{}),
 						},
 
 						"vault_certificates": {
@@ -594,13 +600,14 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 				Required: true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&commonids.NetworkInterfaceId{})),
 				},
 			},
 
 			"primary_network_interface_id": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ResourceIDOfType(&commonids.NetworkInterfaceId{}),
 			},
 
 			"tags": commonschema.Tags(),
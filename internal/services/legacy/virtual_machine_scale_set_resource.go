@@ -14,17 +14,23 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/applicationsecuritygroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/loadbalancers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networksecuritygroups"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	validate2 "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/legacy/migration"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -139,7 +145,7 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 			"health_probe_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&loadbalancers.ProbeId{})),
 			},
 
 			"automatic_os_upgrade": {
@@ -262,7 +268,7 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 						"source_vault_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.KeyVaultId{})),
 						},
 
 						"vault_certificates": {
@@ -412,7 +418,7 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 						"network_security_group_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&networksecuritygroups.NetworkSecurityGroupId{})),
 						},
 
 						"dns_settings": {
@@ -447,14 +453,17 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 									"subnet_id": {
 										Type:         pluginsdk.TypeString,
 										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.SubnetId{})),
 									},
 
 									"application_gateway_backend_address_pool_ids": {
 										Type:     pluginsdk.TypeSet,
 										Optional: true,
-										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-										Set:      pluginsdk.HashString,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.ResourceIDOfType(&networkParse.ApplicationGatewayBackendAddressPoolId{}),
+										},
+										Set: pluginsdk.HashString,
 									},
 
 									"application_security_group_ids": {
@@ -462,7 +471,7 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 										Optional: true,
 										Elem: &pluginsdk.Schema{
 											Type:         pluginsdk.TypeString,
-											ValidateFunc: azure.ValidateResourceID,
+											ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&applicationsecuritygroups.ApplicationSecurityGroupId{})),
 										},
 										Set:      pluginsdk.HashString,
 										MaxItems: 20,
@@ -471,16 +480,22 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 									"load_balancer_backend_address_pool_ids": {
 										Type:     pluginsdk.TypeSet,
 										Optional: true,
-										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-										Set:      pluginsdk.HashString,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.ResourceIDOfType(&loadbalancers.LoadBalancerBackendAddressPoolId{}),
+										},
+										Set: pluginsdk.HashString,
 									},
 
 									"load_balancer_inbound_nat_rules_ids": {
 										Type:     pluginsdk.TypeSet,
 										Optional: true,
 										Computed: true,
-										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-										Set:      pluginsdk.HashString,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.ResourceIDOfType(&loadbalancers.InboundNatRuleId{}),
+										},
+										Set: pluginsdk.HashString,
 									},
 
 									"primary": {
@@ -769,6 +784,7 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 				//
 				// todo can be removed when https://github.com/Azure/azure-sdk-for-go/issues/5699 is fixed
 				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validation.ResourceIDOfType(&proximityplacementgroups.ProximityPlacementGroupId{}),
 			},
 
 			"tags": commonschema.Tags(),
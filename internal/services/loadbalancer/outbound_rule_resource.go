@@ -79,8 +79,9 @@ func resourceArmLoadBalancerOutboundRule() *pluginsdk.Resource {
 			},
 
 			"backend_address_pool_id": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ResourceIDOfType(&loadbalancers.LoadBalancerBackendAddressPoolId{}),
 			},
 
 			"protocol": {
@@ -387,9 +387,10 @@ func resourceArmLoadBalancerRuleSchema() map[string]*pluginsdk.Schema {
 		},
 
 		"probe_id": {
-			Type:     pluginsdk.TypeString,
-			Optional: true,
-			Computed: true,
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.ResourceIDOfType(&loadbalancers.ProbeId{}),
 		},
 
 		// TODO 4.0: change this from enable_* to *_enabled
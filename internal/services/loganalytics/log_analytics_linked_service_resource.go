@@ -223,14 +223,14 @@ func resourceLogAnalyticsLinkedServiceSchema() map[string]*pluginsdk.Schema {
 			Type:         pluginsdk.TypeString,
 			Computed:     true,
 			Optional:     true,
-			ValidateFunc: azure.ValidateResourceID,
+			ValidateFunc: automationaccount.ValidateAutomationAccountID,
 			ExactlyOneOf: []string{"read_access_id", "write_access_id"},
 		},
 
 		"write_access_id": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
-			ValidateFunc: azure.ValidateResourceID,
+			ValidateFunc: clusters.ValidateClusterID,
 			ExactlyOneOf: []string{"read_access_id", "write_access_id"},
 		},
 		// Exported properties
@@ -464,7 +464,7 @@ func (r LogAnalyticsQueryPackQueryResource) Update() sdk.ResourceFunc {
 				parameters.Properties.Related.Solutions = &model.Solutions
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameters.Properties.Tags = expandLogAnalyticsQueryPackQueryTags(model.Tags)
 			}
 
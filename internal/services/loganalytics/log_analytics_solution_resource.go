@@ -91,6 +91,7 @@ func (s LogAnalyticsSolutionResource) Arguments() map[string]*schema.Schema {
 			Required:         true,
 			ForceNew:         true,
 			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc:     validation.ResourceIDOfType(&workspaces.WorkspaceId{}),
 		},
 
 		"location": commonschema.Location(),
@@ -299,7 +300,7 @@ func (s LogAnalyticsSolutionResource) Update() sdk.ResourceFunc {
 
 			payload := solution.SolutionPatch{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				payload.Tags = pointer.To(config.Tags)
 			}
 
@@ -61,8 +61,9 @@ func resourceLogicAppStandard() *pluginsdk.Resource {
 			"location": commonschema.Location(),
 
 			"app_service_plan_id": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ResourceIDOfType(&commonids.AppServicePlanId{}),
 			},
 
 			"app_settings": {
@@ -942,7 +943,7 @@ func schemaLogicAppStandardIpRestriction() *pluginsdk.Schema {
 					"virtual_network_subnet_id": {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&commonids.SubnetId{})),
 					},
 
 					"name": {
@@ -1049,7 +1050,7 @@ func schemaLogicAppStandardIpRestriction() *pluginsdk.Schema {
 				"virtual_network_subnet_id": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&commonids.SubnetId{})),
 				},
 
 				"name": {
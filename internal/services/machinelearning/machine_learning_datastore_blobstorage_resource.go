@@ -74,7 +74,7 @@ func (r MachineLearningDataStoreBlobStorage) Arguments() map[string]*pluginsdk.S
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&commonids.StorageContainerId{})),
 		},
 
 		"description": {
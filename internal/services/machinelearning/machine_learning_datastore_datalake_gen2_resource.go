@@ -83,7 +83,7 @@ func (r MachineLearningDataStoreDataLakeGen2) Arguments() map[string]*pluginsdk.
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&commonids.StorageContainerId{})),
 		},
 
 		"tenant_id": {
@@ -61,9 +61,10 @@ func resourceAksInferenceCluster() *pluginsdk.Resource {
 			"location": commonschema.Location(),
 
 			"machine_learning_workspace_id": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceIDOfType(&workspaces.WorkspaceId{}),
 			},
 
 			"cluster_purpose": {
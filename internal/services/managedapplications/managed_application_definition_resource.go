@@ -227,7 +227,7 @@ func resourceManagedApplicationDefinitionUpdate(d *pluginsdk.ResourceData, meta
 		payload.Properties.PackageFileUri = pointer.To(d.Get("package_file_uri").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -275,7 +275,7 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleUpdate(d *pluginsdk.Resourc
 
 	model := resp.Model
 	hasUpdate := false
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		hasUpdate = true
 		model.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
@@ -381,7 +381,7 @@ func resourceMediaStreamingEndpointUpdate(d *pluginsdk.ResourceData, meta interf
 		existing.Properties.MaxCacheAge = utils.Int64(int64(d.Get("max_cache_age_seconds").(int)))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -496,7 +496,7 @@ func resourceMediaLiveEventUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		existing.Properties.Transcriptions = expandTranscriptions(d.Get("transcription_languages").([]interface{}))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -382,7 +382,7 @@ func (r AttachedDataNetworkResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				// pass empty array instead of nil to remove all tags
 				attachedDataNetwork.Tags = tags.Expand(plan.Tags)
 			}
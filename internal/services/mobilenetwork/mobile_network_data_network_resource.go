@@ -150,7 +150,7 @@ func (r DataNetworkResource) Update() sdk.ResourceFunc {
 				properties.Properties.Description = &model.Description
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
@@ -200,7 +200,7 @@ func (r PacketCoreDataPlaneResource) Update() sdk.ResourceFunc {
 				model.Properties.UserPlaneAccessInterface.IPv4Gateway = &plan.UserPlaneAccessIPv4Gateway
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = &plan.Tags
 			}
 
@@ -381,7 +381,7 @@ func (r SimPolicyResource) Update() sdk.ResourceFunc {
 				model.Properties.UeAmbr = expandAmbrResourceModel(plan.UeAmbr)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = &plan.Tags
 			}
 
@@ -194,7 +194,7 @@ func (r SliceResource) Update() sdk.ResourceFunc {
 				updateModel.Properties.Snssai = expandSingleNetworkSliceSelectionAssistanceInformationResourceModel(model.SingleNetworkSliceSelectionAssistanceInformation)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				updateModel.Tags = &model.Tags
 			}
 
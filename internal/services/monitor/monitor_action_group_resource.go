@@ -12,9 +12,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2015-10-31/webhook"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2022-08-08/automationaccount"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-01-01/actiongroupsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -267,7 +269,7 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 						"webhook_resource_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&webhook.WebHookId{})),
 						},
 						"is_global_runbook": {
 							Type:     pluginsdk.TypeBool,
@@ -324,7 +326,7 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 						"resource_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&workflows.WorkflowId{})),
 						},
 						"callback_url": {
 							Type:         pluginsdk.TypeString,
@@ -151,7 +151,7 @@ func (r AlertProcessingRuleSuppressionResource) Update() sdk.ResourceFunc {
 				model.Properties.Scopes = resourceModel.Scopes
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				model.Tags = &resourceModel.Tags
 			}
 
@@ -239,7 +239,7 @@ func (r DataCollectionEndpointResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				existing.Tags = tags.Expand(state.Tags)
 			}
 
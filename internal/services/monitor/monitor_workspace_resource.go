@@ -155,7 +155,7 @@ func (r WorkspaceResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: model was nil", *id)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				resp.Model.Tags = pointer.To(model.Tags)
 			}
 
@@ -330,7 +330,7 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if payload := existing.Model; payload != nil {
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "tags_all") {
 			payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 		}
 
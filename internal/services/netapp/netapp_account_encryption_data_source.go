@@ -10,12 +10,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/netappaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	netAppModels "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/models"
 	netAppValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type NetAppAccountEncryptionDataSource struct{}
@@ -49,6 +51,7 @@ func (r NetAppAccountEncryptionDataSource) Arguments() map[string]*pluginsdk.Sch
 			Optional:      true,
 			Description:   "The resource ID of the User Assigned Identity to use for encryption.",
 			ConflictsWith: []string{"system_assigned_identity_principal_id"},
+			ValidateFunc:  validation.ResourceIDOfType(&commonids.UserAssignedIdentityId{}),
 		},
 
 		"system_assigned_identity_principal_id": {
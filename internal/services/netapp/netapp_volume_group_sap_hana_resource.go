@@ -12,10 +12,13 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/capacitypools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/snapshotpolicy"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumesreplication"
@@ -101,14 +104,14 @@ func (r NetAppVolumeGroupSapHanaResource) Arguments() map[string]*pluginsdk.Sche
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						ForceNew:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&capacitypools.CapacityPoolId{})),
 					},
 
 					"proximity_placement_group_id": {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						ForceNew:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&proximityplacementgroups.ProximityPlacementGroupId{})),
 					},
 
 					"volume_spec_name": {
@@ -140,7 +143,7 @@ func (r NetAppVolumeGroupSapHanaResource) Arguments() map[string]*pluginsdk.Sche
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						ForceNew:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.SubnetId{})),
 					},
 
 					"protocols": {
@@ -259,7 +262,7 @@ func (r NetAppVolumeGroupSapHanaResource) Arguments() map[string]*pluginsdk.Sche
 								"remote_volume_resource_id": {
 									Type:         pluginsdk.TypeString,
 									Required:     true,
-									ValidateFunc: azure.ValidateResourceID,
+									ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&volumes.VolumeId{})),
 								},
 
 								"replication_frequency": {
@@ -280,7 +283,7 @@ func (r NetAppVolumeGroupSapHanaResource) Arguments() map[string]*pluginsdk.Sche
 								"snapshot_policy_id": {
 									Type:         pluginsdk.TypeString,
 									Required:     true,
-									ValidateFunc: azure.ValidateResourceID,
+									ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&snapshotpolicy.SnapshotPolicyId{})),
 								},
 							},
 						},
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-07-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationGatewayBackendAddressPoolModel struct {
+	Name                 string   `tfschema:"name"`
+	ApplicationGatewayId string   `tfschema:"application_gateway_id"`
+	Fqdns                []string `tfschema:"fqdns"`
+	IPAddresses          []string `tfschema:"ip_addresses"`
+}
+
+type ApplicationGatewayBackendAddressPoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayBackendAddressPoolResource{}
+
+func (r ApplicationGatewayBackendAddressPoolResource) ResourceType() string {
+	return "azurerm_application_gateway_backend_address_pool"
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) ModelObject() interface{} {
+	return &ApplicationGatewayBackendAddressPoolModel{}
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkValidate.BackendAddressPoolID
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationgateways.ValidateApplicationGatewayID,
+		},
+
+		"fqdns": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+
+		"ip_addresses": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.IPv4Address,
+			},
+		},
+	}
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			var model ApplicationGatewayBackendAddressPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId, err := applicationgateways.ParseApplicationGatewayID(model.ApplicationGatewayId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewBackendAddressPoolID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, model.Name)
+
+			err = updateApplicationGateway(ctx, client, *gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				pools := pointer.From(props.BackendAddressPools)
+				for _, v := range pools {
+					if pointer.From(v.Name) == id.Name {
+						return metadata.ResourceRequiresImport(r.ResourceType(), id)
+					}
+				}
+
+				props.BackendAddressPools = pointer.To(append(pools, expandApplicationGatewayBackendAddressPoolModel(model)))
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.BackendAddressPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			resp, err := client.Get(ctx, gatewayId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+			}
+
+			var pool *applicationgateways.ApplicationGatewayBackendAddressPool
+			if model := resp.Model; model != nil && model.Properties != nil {
+				for _, v := range pointer.From(model.Properties.BackendAddressPools) {
+					if pointer.From(v.Name) == id.Name {
+						pool = pointer.To(v)
+						break
+					}
+				}
+			}
+			if pool == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ApplicationGatewayBackendAddressPoolModel{
+				Name:                 id.Name,
+				ApplicationGatewayId: gatewayId.ID(),
+				Fqdns:                make([]string, 0),
+				IPAddresses:          make([]string, 0),
+			}
+
+			if props := pool.Properties; props != nil {
+				for _, address := range pointer.From(props.BackendAddresses) {
+					if address.IPAddress != nil {
+						state.IPAddresses = append(state.IPAddresses, *address.IPAddress)
+					} else if address.Fqdn != nil {
+						state.Fqdns = append(state.Fqdns, *address.Fqdn)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.BackendAddressPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationGatewayBackendAddressPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				pools := pointer.From(props.BackendAddressPools)
+				for i, v := range pools {
+					if pointer.From(v.Name) == id.Name {
+						pools[i] = expandApplicationGatewayBackendAddressPoolModel(model)
+						props.BackendAddressPools = pointer.To(pools)
+						return nil
+					}
+				}
+
+				return fmt.Errorf("%s was not found", id)
+			})
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.BackendAddressPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				pools := make([]applicationgateways.ApplicationGatewayBackendAddressPool, 0)
+				for _, v := range pointer.From(props.BackendAddressPools) {
+					if pointer.From(v.Name) != id.Name {
+						pools = append(pools, v)
+					}
+				}
+				props.BackendAddressPools = pointer.To(pools)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationGatewayBackendAddressPoolModel(input ApplicationGatewayBackendAddressPoolModel) applicationgateways.ApplicationGatewayBackendAddressPool {
+	backendAddresses := make([]applicationgateways.ApplicationGatewayBackendAddress, 0)
+	for _, fqdn := range input.Fqdns {
+		backendAddresses = append(backendAddresses, applicationgateways.ApplicationGatewayBackendAddress{
+			Fqdn: pointer.To(fqdn),
+		})
+	}
+	for _, ipAddress := range input.IPAddresses {
+		backendAddresses = append(backendAddresses, applicationgateways.ApplicationGatewayBackendAddress{
+			IPAddress: pointer.To(ipAddress),
+		})
+	}
+
+	return applicationgateways.ApplicationGatewayBackendAddressPool{
+		Name: pointer.To(input.Name),
+		Properties: &applicationgateways.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-07-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// updateApplicationGateway retrieves the specified Application Gateway, calls `update` to modify its properties and
+// then sends the Application Gateway back to the API. This is used by the resources which manage a single child item
+// (e.g. a Probe) within an Application Gateway, so the Application Gateway is locked for the duration of the update
+// to avoid concurrent changes to other child items being lost.
+func updateApplicationGateway(ctx context.Context, client *applicationgateways.ApplicationGatewaysClient, id applicationgateways.ApplicationGatewayId, update func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error) error {
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+	if existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	if err := update(existing.Model.Properties); err != nil {
+		return err
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *existing.Model); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// isExternallyManagedApplicationGatewayChild returns whether the named item within the specified block of the
+// `azurerm_application_gateway` resource is managed outside of it - that is, it's listed in
+// `ignore_externally_managed_children` and isn't defined within the block itself.
+func isExternallyManagedApplicationGatewayChild(d *pluginsdk.ResourceData, key string, name *string) bool {
+	if name == nil {
+		return false
+	}
+
+	if _, ok := applicationGatewayExternallyManagedChildNames(d)[*name]; !ok {
+		return false
+	}
+
+	_, definedInline := applicationGatewayBlockNames(d.Get(key))[*name]
+	return !definedInline
+}
+
+// withoutExternallyManagedApplicationGatewayChildren removes the items from the flattened block which are listed in
+// `ignore_externally_managed_children` (and not defined within the block itself), since these are managed by a
+// separate resource (e.g. `azurerm_application_gateway_probe`).
+func withoutExternallyManagedApplicationGatewayChildren(d *pluginsdk.ResourceData, key string, input []interface{}) []interface{} {
+	externallyManaged := applicationGatewayExternallyManagedChildNames(d)
+	if len(externallyManaged) == 0 {
+		return input
+	}
+
+	definedInline := applicationGatewayBlockNames(d.Get(key))
+	output := make([]interface{}, 0)
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := v["name"].(string); ok {
+			if _, ignored := externallyManaged[name]; ignored {
+				if _, inline := definedInline[name]; !inline {
+					continue
+				}
+			}
+		}
+
+		output = append(output, v)
+	}
+
+	return output
+}
+
+func applicationGatewayExternallyManagedChildNames(d *pluginsdk.ResourceData) map[string]struct{} {
+	output := make(map[string]struct{})
+
+	set, ok := d.Get("ignore_externally_managed_children").(*pluginsdk.Set)
+	if !ok || set == nil {
+		return output
+	}
+
+	for _, raw := range set.List() {
+		if name, ok := raw.(string); ok {
+			output[name] = struct{}{}
+		}
+	}
+
+	return output
+}
+
+func applicationGatewayBlockNames(input interface{}) map[string]struct{} {
+	output := make(map[string]struct{})
+
+	set, ok := input.(*pluginsdk.Set)
+	if !ok || set == nil {
+		return output
+	}
+
+	for _, raw := range set.List() {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := v["name"].(string); ok {
+			output[name] = struct{}{}
+		}
+	}
+
+	return output
+}
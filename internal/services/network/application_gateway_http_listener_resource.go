@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-07-01/applicationgateways"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationGatewayHttpListenerModel struct {
+	Name                        string                                           `tfschema:"name"`
+	ApplicationGatewayId        string                                           `tfschema:"application_gateway_id"`
+	FrontendIPConfigurationName string                                           `tfschema:"frontend_ip_configuration_name"`
+	FrontendPortName            string                                           `tfschema:"frontend_port_name"`
+	Protocol                    string                                           `tfschema:"protocol"`
+	HostName                    string                                           `tfschema:"host_name"`
+	HostNames                   []string                                         `tfschema:"host_names"`
+	SslCertificateName          string                                           `tfschema:"ssl_certificate_name"`
+	SslProfileName              string                                           `tfschema:"ssl_profile_name"`
+	RequireSni                  bool                                             `tfschema:"require_sni"`
+	FirewallPolicyId            string                                           `tfschema:"firewall_policy_id"`
+	CustomErrorConfigurations   []ApplicationGatewayHttpListenerCustomErrorModel `tfschema:"custom_error_configuration"`
+}
+
+type ApplicationGatewayHttpListenerCustomErrorModel struct {
+	StatusCode         string `tfschema:"status_code"`
+	CustomErrorPageUrl string `tfschema:"custom_error_page_url"`
+}
+
+type ApplicationGatewayHttpListenerResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayHttpListenerResource{}
+
+func (r ApplicationGatewayHttpListenerResource) ResourceType() string {
+	return "azurerm_application_gateway_http_listener"
+}
+
+func (r ApplicationGatewayHttpListenerResource) ModelObject() interface{} {
+	return &ApplicationGatewayHttpListenerModel{}
+}
+
+func (r ApplicationGatewayHttpListenerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkValidate.HttpListenerID
+}
+
+func (r ApplicationGatewayHttpListenerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationgateways.ValidateApplicationGatewayID,
+		},
+
+		"frontend_ip_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"frontend_port_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(applicationgateways.ApplicationGatewayProtocolHTTP),
+				string(applicationgateways.ApplicationGatewayProtocolHTTPS),
+			}, false),
+		},
+
+		"host_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"host_names"},
+		},
+
+		"host_names": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			ConflictsWith: []string{"host_name"},
+		},
+
+		"ssl_certificate_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ssl_profile_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"require_sni": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: webapplicationfirewallpolicies.ValidateApplicationGatewayWebApplicationFirewallPolicyID,
+		},
+
+		"custom_error_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"status_code": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(applicationgateways.ApplicationGatewayCustomErrorStatusCodeHTTPStatusFourZeroThree),
+							string(applicationgateways.ApplicationGatewayCustomErrorStatusCodeHTTPStatusFiveZeroTwo),
+						}, false),
+					},
+
+					"custom_error_page_url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ApplicationGatewayHttpListenerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationGatewayHttpListenerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			var model ApplicationGatewayHttpListenerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId, err := applicationgateways.ParseApplicationGatewayID(model.ApplicationGatewayId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewHttpListenerID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, model.Name)
+
+			err = updateApplicationGateway(ctx, client, *gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				listeners := pointer.From(props.HTTPListeners)
+				for _, v := range listeners {
+					if pointer.From(v.Name) == id.Name {
+						return metadata.ResourceRequiresImport(r.ResourceType(), id)
+					}
+				}
+
+				props.HTTPListeners = pointer.To(append(listeners, expandApplicationGatewayHttpListenerModel(model, *gatewayId)))
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewayHttpListenerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.HttpListenerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			resp, err := client.Get(ctx, gatewayId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+			}
+
+			var listener *applicationgateways.ApplicationGatewayHTTPListener
+			if model := resp.Model; model != nil && model.Properties != nil {
+				for _, v := range pointer.From(model.Properties.HTTPListeners) {
+					if pointer.From(v.Name) == id.Name {
+						listener = pointer.To(v)
+						break
+					}
+				}
+			}
+			if listener == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ApplicationGatewayHttpListenerModel{
+				Name:                      id.Name,
+				ApplicationGatewayId:      gatewayId.ID(),
+				CustomErrorConfigurations: make([]ApplicationGatewayHttpListenerCustomErrorModel, 0),
+			}
+
+			if props := listener.Properties; props != nil {
+				state.Protocol = string(pointer.From(props.Protocol))
+				state.HostName = pointer.From(props.HostName)
+				state.HostNames = pointer.From(props.HostNames)
+				state.RequireSni = pointer.From(props.RequireServerNameIndication)
+
+				if props.FrontendIPConfiguration != nil && props.FrontendIPConfiguration.Id != nil {
+					frontendIPConfigurationId, err := parse.FrontendIPConfigurationIDInsensitively(*props.FrontendIPConfiguration.Id)
+					if err != nil {
+						return err
+					}
+					state.FrontendIPConfigurationName = frontendIPConfigurationId.Name
+				}
+
+				if props.FrontendPort != nil && props.FrontendPort.Id != nil {
+					frontendPortId, err := parse.FrontendPortIDInsensitively(*props.FrontendPort.Id)
+					if err != nil {
+						return err
+					}
+					state.FrontendPortName = frontendPortId.Name
+				}
+
+				if props.SslCertificate != nil && props.SslCertificate.Id != nil {
+					sslCertificateId, err := parse.SslCertificateIDInsensitively(*props.SslCertificate.Id)
+					if err != nil {
+						return err
+					}
+					state.SslCertificateName = sslCertificateId.Name
+				}
+
+				if props.SslProfile != nil && props.SslProfile.Id != nil {
+					sslProfileId, err := parse.SslProfileIDInsensitively(*props.SslProfile.Id)
+					if err != nil {
+						return err
+					}
+					state.SslProfileName = sslProfileId.Name
+				}
+
+				if props.FirewallPolicy != nil && props.FirewallPolicy.Id != nil {
+					policyId, err := webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyIDInsensitively(*props.FirewallPolicy.Id)
+					if err != nil {
+						return err
+					}
+					state.FirewallPolicyId = policyId.ID()
+				}
+
+				for _, v := range pointer.From(props.CustomErrorConfigurations) {
+					state.CustomErrorConfigurations = append(state.CustomErrorConfigurations, ApplicationGatewayHttpListenerCustomErrorModel{
+						StatusCode:         string(pointer.From(v.StatusCode)),
+						CustomErrorPageUrl: pointer.From(v.CustomErrorPageUrl),
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationGatewayHttpListenerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.HttpListenerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationGatewayHttpListenerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				listeners := pointer.From(props.HTTPListeners)
+				for i, v := range listeners {
+					if pointer.From(v.Name) == id.Name {
+						listeners[i] = expandApplicationGatewayHttpListenerModel(model, gatewayId)
+						props.HTTPListeners = pointer.To(listeners)
+						return nil
+					}
+				}
+
+				return fmt.Errorf("%s was not found", id)
+			})
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewayHttpListenerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.HttpListenerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				listeners := make([]applicationgateways.ApplicationGatewayHTTPListener, 0)
+				for _, v := range pointer.From(props.HTTPListeners) {
+					if pointer.From(v.Name) != id.Name {
+						listeners = append(listeners, v)
+					}
+				}
+				props.HTTPListeners = pointer.To(listeners)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationGatewayHttpListenerModel(input ApplicationGatewayHttpListenerModel, gatewayId applicationgateways.ApplicationGatewayId) applicationgateways.ApplicationGatewayHTTPListener {
+	customErrorConfigurations := make([]applicationgateways.ApplicationGatewayCustomError, 0)
+	for _, v := range input.CustomErrorConfigurations {
+		customErrorConfigurations = append(customErrorConfigurations, applicationgateways.ApplicationGatewayCustomError{
+			StatusCode:         pointer.To(applicationgateways.ApplicationGatewayCustomErrorStatusCode(v.StatusCode)),
+			CustomErrorPageUrl: pointer.To(v.CustomErrorPageUrl),
+		})
+	}
+
+	output := applicationgateways.ApplicationGatewayHTTPListener{
+		Name: pointer.To(input.Name),
+		Properties: &applicationgateways.ApplicationGatewayHTTPListenerPropertiesFormat{
+			CustomErrorConfigurations: &customErrorConfigurations,
+			FrontendIPConfiguration: &applicationgateways.SubResource{
+				Id: pointer.To(parse.NewFrontendIPConfigurationID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.FrontendIPConfigurationName).ID()),
+			},
+			FrontendPort: &applicationgateways.SubResource{
+				Id: pointer.To(parse.NewFrontendPortID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.FrontendPortName).ID()),
+			},
+			Protocol:                    pointer.To(applicationgateways.ApplicationGatewayProtocol(input.Protocol)),
+			RequireServerNameIndication: pointer.To(input.RequireSni),
+		},
+	}
+
+	if input.HostName != "" {
+		output.Properties.HostName = pointer.To(input.HostName)
+	}
+
+	if len(input.HostNames) > 0 {
+		output.Properties.HostNames = pointer.To(input.HostNames)
+	}
+
+	if input.SslCertificateName != "" {
+		output.Properties.SslCertificate = &applicationgateways.SubResource{
+			Id: pointer.To(parse.NewSslCertificateID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.SslCertificateName).ID()),
+		}
+	}
+
+	if input.SslProfileName != "" {
+		output.Properties.SslProfile = &applicationgateways.SubResource{
+			Id: pointer.To(parse.NewSslProfileID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.SslProfileName).ID()),
+		}
+	}
+
+	if input.FirewallPolicyId != "" {
+		output.Properties.FirewallPolicy = &applicationgateways.SubResource{
+			Id: pointer.To(input.FirewallPolicyId),
+		}
+	}
+
+	return output
+}
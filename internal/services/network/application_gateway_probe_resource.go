@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-07-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationGatewayProbeModel struct {
+	Name                                string                              `tfschema:"name"`
+	ApplicationGatewayId                string                              `tfschema:"application_gateway_id"`
+	Protocol                            string                              `tfschema:"protocol"`
+	Path                                string                              `tfschema:"path"`
+	Host                                string                              `tfschema:"host"`
+	Interval                            int64                               `tfschema:"interval"`
+	Timeout                             int64                               `tfschema:"timeout"`
+	UnhealthyThreshold                  int64                               `tfschema:"unhealthy_threshold"`
+	Port                                int64                               `tfschema:"port"`
+	PickHostNameFromBackendHTTPSettings bool                                `tfschema:"pick_host_name_from_backend_http_settings"`
+	MinimumServers                      int64                               `tfschema:"minimum_servers"`
+	Match                               []ApplicationGatewayProbeMatchModel `tfschema:"match"`
+}
+
+type ApplicationGatewayProbeMatchModel struct {
+	Body        string   `tfschema:"body"`
+	StatusCodes []string `tfschema:"status_code"`
+}
+
+type ApplicationGatewayProbeResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayProbeResource{}
+
+func (r ApplicationGatewayProbeResource) ResourceType() string {
+	return "azurerm_application_gateway_probe"
+}
+
+func (r ApplicationGatewayProbeResource) ModelObject() interface{} {
+	return &ApplicationGatewayProbeModel{}
+}
+
+func (r ApplicationGatewayProbeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkValidate.ProbeID
+}
+
+func (r ApplicationGatewayProbeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationgateways.ValidateApplicationGatewayID,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(applicationgateways.ApplicationGatewayProtocolHTTP),
+				string(applicationgateways.ApplicationGatewayProtocolHTTPS),
+			}, false),
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"interval": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"timeout": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"unhealthy_threshold": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"host": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"host", "pick_host_name_from_backend_http_settings"},
+		},
+
+		"pick_host_name_from_backend_http_settings": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			ExactlyOneOf: []string{"host", "pick_host_name_from_backend_http_settings"},
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.PortNumber,
+		},
+
+		"minimum_servers": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"match": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"status_code": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"body": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ApplicationGatewayProbeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationGatewayProbeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			var model ApplicationGatewayProbeModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId, err := applicationgateways.ParseApplicationGatewayID(model.ApplicationGatewayId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewProbeID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, model.Name)
+
+			probe := expandApplicationGatewayProbeModel(model)
+
+			err = updateApplicationGateway(ctx, client, *gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				probes := pointer.From(props.Probes)
+				for _, v := range probes {
+					if pointer.From(v.Name) == id.Name {
+						return metadata.ResourceRequiresImport(r.ResourceType(), id)
+					}
+				}
+
+				props.Probes = pointer.To(append(probes, *probe))
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewayProbeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.ProbeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			resp, err := client.Get(ctx, gatewayId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+			}
+
+			var probe *applicationgateways.ApplicationGatewayProbe
+			if model := resp.Model; model != nil && model.Properties != nil {
+				for _, v := range pointer.From(model.Properties.Probes) {
+					if pointer.From(v.Name) == id.Name {
+						probe = pointer.To(v)
+						break
+					}
+				}
+			}
+			if probe == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ApplicationGatewayProbeModel{
+				Name:                 id.Name,
+				ApplicationGatewayId: gatewayId.ID(),
+				Match:                make([]ApplicationGatewayProbeMatchModel, 0),
+			}
+
+			if props := probe.Properties; props != nil {
+				state.Protocol = string(pointer.From(props.Protocol))
+				state.Path = pointer.From(props.Path)
+				state.Host = pointer.From(props.Host)
+				state.Interval = pointer.From(props.Interval)
+				state.Timeout = pointer.From(props.Timeout)
+				state.UnhealthyThreshold = pointer.From(props.UnhealthyThreshold)
+				state.Port = pointer.From(props.Port)
+				state.PickHostNameFromBackendHTTPSettings = pointer.From(props.PickHostNameFromBackendHTTPSettings)
+				state.MinimumServers = pointer.From(props.MinServers)
+
+				if match := props.Match; match != nil {
+					state.Match = append(state.Match, ApplicationGatewayProbeMatchModel{
+						Body:        pointer.From(match.Body),
+						StatusCodes: pointer.From(match.StatusCodes),
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationGatewayProbeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.ProbeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationGatewayProbeModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			probe := expandApplicationGatewayProbeModel(model)
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				probes := pointer.From(props.Probes)
+				for i, v := range probes {
+					if pointer.From(v.Name) == id.Name {
+						probes[i] = *probe
+						props.Probes = pointer.To(probes)
+						return nil
+					}
+				}
+
+				return fmt.Errorf("%s was not found", id)
+			})
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewayProbeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.ProbeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				probes := make([]applicationgateways.ApplicationGatewayProbe, 0)
+				for _, v := range pointer.From(props.Probes) {
+					if pointer.From(v.Name) != id.Name {
+						probes = append(probes, v)
+					}
+				}
+				props.Probes = pointer.To(probes)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationGatewayProbeModel(input ApplicationGatewayProbeModel) *applicationgateways.ApplicationGatewayProbe {
+	output := applicationgateways.ApplicationGatewayProbe{
+		Name: pointer.To(input.Name),
+		Properties: &applicationgateways.ApplicationGatewayProbePropertiesFormat{
+			Host:                                pointer.To(input.Host),
+			Interval:                            pointer.To(input.Interval),
+			MinServers:                          pointer.To(input.MinimumServers),
+			Path:                                pointer.To(input.Path),
+			PickHostNameFromBackendHTTPSettings: pointer.To(input.PickHostNameFromBackendHTTPSettings),
+			Protocol:                            pointer.To(applicationgateways.ApplicationGatewayProtocol(input.Protocol)),
+			Timeout:                             pointer.To(input.Timeout),
+			UnhealthyThreshold:                  pointer.To(input.UnhealthyThreshold),
+		},
+	}
+
+	if input.Port != 0 {
+		output.Properties.Port = pointer.To(input.Port)
+	}
+
+	if len(input.Match) > 0 {
+		output.Properties.Match = &applicationgateways.ApplicationGatewayProbeHealthResponseMatch{
+			Body:        pointer.To(input.Match[0].Body),
+			StatusCodes: pointer.To(input.Match[0].StatusCodes),
+		}
+	}
+
+	return &output
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-07-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationGatewayRequestRoutingRuleModel struct {
+	Name                      string `tfschema:"name"`
+	ApplicationGatewayId      string `tfschema:"application_gateway_id"`
+	RuleType                  string `tfschema:"rule_type"`
+	HttpListenerName          string `tfschema:"http_listener_name"`
+	BackendAddressPoolName    string `tfschema:"backend_address_pool_name"`
+	BackendHttpSettingsName   string `tfschema:"backend_http_settings_name"`
+	RedirectConfigurationName string `tfschema:"redirect_configuration_name"`
+	RewriteRuleSetName        string `tfschema:"rewrite_rule_set_name"`
+	UrlPathMapName            string `tfschema:"url_path_map_name"`
+	Priority                  int64  `tfschema:"priority"`
+}
+
+type ApplicationGatewayRequestRoutingRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayRequestRoutingRuleResource{}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) ResourceType() string {
+	return "azurerm_application_gateway_request_routing_rule"
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) ModelObject() interface{} {
+	return &ApplicationGatewayRequestRoutingRuleModel{}
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkValidate.RequestRoutingRuleID
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationgateways.ValidateApplicationGatewayID,
+		},
+
+		"rule_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(applicationgateways.ApplicationGatewayRequestRoutingRuleTypeBasic),
+				string(applicationgateways.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+			}, false),
+		},
+
+		"http_listener_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"backend_address_pool_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"redirect_configuration_name"},
+		},
+
+		"backend_http_settings_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"redirect_configuration_name"},
+		},
+
+		"redirect_configuration_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"backend_address_pool_name", "backend_http_settings_name"},
+		},
+
+		"rewrite_rule_set_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"url_path_map_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 20000),
+		},
+	}
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			var model ApplicationGatewayRequestRoutingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId, err := applicationgateways.ParseApplicationGatewayID(model.ApplicationGatewayId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewRequestRoutingRuleID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, model.Name)
+
+			err = updateApplicationGateway(ctx, client, *gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				rules := pointer.From(props.RequestRoutingRules)
+				for _, v := range rules {
+					if pointer.From(v.Name) == id.Name {
+						return metadata.ResourceRequiresImport(r.ResourceType(), id)
+					}
+				}
+
+				rules = append(rules, expandApplicationGatewayRequestRoutingRuleModel(model, *gatewayId))
+				if err := validateApplicationGatewayRequestRoutingRulePriorities(rules); err != nil {
+					return err
+				}
+
+				props.RequestRoutingRules = pointer.To(rules)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.RequestRoutingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			resp, err := client.Get(ctx, gatewayId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+			}
+
+			var rule *applicationgateways.ApplicationGatewayRequestRoutingRule
+			if model := resp.Model; model != nil && model.Properties != nil {
+				for _, v := range pointer.From(model.Properties.RequestRoutingRules) {
+					if pointer.From(v.Name) == id.Name {
+						rule = pointer.To(v)
+						break
+					}
+				}
+			}
+			if rule == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ApplicationGatewayRequestRoutingRuleModel{
+				Name:                 id.Name,
+				ApplicationGatewayId: gatewayId.ID(),
+			}
+
+			if props := rule.Properties; props != nil {
+				state.RuleType = string(pointer.From(props.RuleType))
+				state.Priority = pointer.From(props.Priority)
+
+				if props.HTTPListener != nil && props.HTTPListener.Id != nil {
+					listenerId, err := parse.HttpListenerIDInsensitively(*props.HTTPListener.Id)
+					if err != nil {
+						return err
+					}
+					state.HttpListenerName = listenerId.Name
+				}
+
+				if props.BackendAddressPool != nil && props.BackendAddressPool.Id != nil {
+					poolId, err := parse.BackendAddressPoolIDInsensitively(*props.BackendAddressPool.Id)
+					if err != nil {
+						return err
+					}
+					state.BackendAddressPoolName = poolId.Name
+				}
+
+				if props.BackendHTTPSettings != nil && props.BackendHTTPSettings.Id != nil {
+					settingsId, err := parse.BackendHttpSettingsCollectionIDInsensitively(*props.BackendHTTPSettings.Id)
+					if err != nil {
+						return err
+					}
+					state.BackendHttpSettingsName = settingsId.BackendHttpSettingsCollectionName
+				}
+
+				if props.RedirectConfiguration != nil && props.RedirectConfiguration.Id != nil {
+					redirectId, err := parse.RedirectConfigurationsIDInsensitively(*props.RedirectConfiguration.Id)
+					if err != nil {
+						return err
+					}
+					state.RedirectConfigurationName = redirectId.RedirectConfigurationName
+				}
+
+				if props.RewriteRuleSet != nil && props.RewriteRuleSet.Id != nil {
+					rewriteId, err := parse.RewriteRuleSetIDInsensitively(*props.RewriteRuleSet.Id)
+					if err != nil {
+						return err
+					}
+					state.RewriteRuleSetName = rewriteId.Name
+				}
+
+				if props.UrlPathMap != nil && props.UrlPathMap.Id != nil {
+					pathMapId, err := parse.UrlPathMapIDInsensitively(*props.UrlPathMap.Id)
+					if err != nil {
+						return err
+					}
+					state.UrlPathMapName = pathMapId.Name
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.RequestRoutingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationGatewayRequestRoutingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				rules := pointer.From(props.RequestRoutingRules)
+				for i, v := range rules {
+					if pointer.From(v.Name) == id.Name {
+						rules[i] = expandApplicationGatewayRequestRoutingRuleModel(model, gatewayId)
+						if err := validateApplicationGatewayRequestRoutingRulePriorities(rules); err != nil {
+							return err
+						}
+
+						props.RequestRoutingRules = pointer.To(rules)
+						return nil
+					}
+				}
+
+				return fmt.Errorf("%s was not found", id)
+			})
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.RequestRoutingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				rules := make([]applicationgateways.ApplicationGatewayRequestRoutingRule, 0)
+				for _, v := range pointer.From(props.RequestRoutingRules) {
+					if pointer.From(v.Name) != id.Name {
+						rules = append(rules, v)
+					}
+				}
+				props.RequestRoutingRules = pointer.To(rules)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationGatewayRequestRoutingRuleModel(input ApplicationGatewayRequestRoutingRuleModel, gatewayId applicationgateways.ApplicationGatewayId) applicationgateways.ApplicationGatewayRequestRoutingRule {
+	output := applicationgateways.ApplicationGatewayRequestRoutingRule{
+		Name: pointer.To(input.Name),
+		Properties: &applicationgateways.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: pointer.To(applicationgateways.ApplicationGatewayRequestRoutingRuleType(input.RuleType)),
+			HTTPListener: &applicationgateways.SubResource{
+				Id: pointer.To(parse.NewHttpListenerID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.HttpListenerName).ID()),
+			},
+		},
+	}
+
+	if input.BackendAddressPoolName != "" {
+		output.Properties.BackendAddressPool = &applicationgateways.SubResource{
+			Id: pointer.To(parse.NewBackendAddressPoolID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.BackendAddressPoolName).ID()),
+		}
+	}
+
+	if input.BackendHttpSettingsName != "" {
+		output.Properties.BackendHTTPSettings = &applicationgateways.SubResource{
+			Id: pointer.To(parse.NewBackendHttpSettingsCollectionID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.BackendHttpSettingsName).ID()),
+		}
+	}
+
+	if input.RedirectConfigurationName != "" {
+		output.Properties.RedirectConfiguration = &applicationgateways.SubResource{
+			Id: pointer.To(parse.NewRedirectConfigurationsID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.RedirectConfigurationName).ID()),
+		}
+	}
+
+	if input.RewriteRuleSetName != "" {
+		output.Properties.RewriteRuleSet = &applicationgateways.SubResource{
+			Id: pointer.To(parse.NewRewriteRuleSetID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.RewriteRuleSetName).ID()),
+		}
+	}
+
+	if input.UrlPathMapName != "" {
+		output.Properties.UrlPathMap = &applicationgateways.SubResource{
+			Id: pointer.To(parse.NewUrlPathMapID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, input.UrlPathMapName).ID()),
+		}
+	}
+
+	if input.Priority != 0 {
+		output.Properties.Priority = pointer.To(input.Priority)
+	}
+
+	return output
+}
+
+// validateApplicationGatewayRequestRoutingRulePriorities ensures that either all or none of the Request Routing
+// Rules within an Application Gateway specify a priority, since the API doesn't allow these to be mixed
+func validateApplicationGatewayRequestRoutingRulePriorities(input []applicationgateways.ApplicationGatewayRequestRoutingRule) error {
+	withPriority := 0
+	for _, v := range input {
+		if v.Properties != nil && v.Properties.Priority != nil {
+			withPriority++
+		}
+	}
+
+	if withPriority > 0 && withPriority != len(input) {
+		return fmt.Errorf("either all or none of the Request Routing Rules within an Application Gateway must specify a `priority`")
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -318,8 +319,9 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 						},
 
 						"subnet_id": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.ResourceIDOfType(&commonids.SubnetId{}),
 						},
 
 						"private_ip_address": {
@@ -329,8 +331,9 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 						},
 
 						"public_ip_address_id": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.ResourceIDOfType(&commonids.PublicIPAddressId{}),
 						},
 
 						"private_ip_address_allocation": {
@@ -913,6 +916,15 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"ignore_externally_managed_children": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
 			// lintignore:S016,S023
 			"probe": {
 				Type:     pluginsdk.TypeSet,
@@ -1566,6 +1578,9 @@ func resourceApplicationGatewayCreate(d *pluginsdk.ResourceData, meta interface{
 		return tf.ImportAsExistsError("azurerm_application_gateway", id.ID())
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	enablehttp2 := d.Get("enable_http2").(bool)
 	t := d.Get("tags").(map[string]interface{})
 
@@ -1734,6 +1749,9 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	existing, err := client.Get(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -1745,7 +1763,7 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	payload := existing.Model
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -1753,6 +1771,10 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		payload.Properties = &applicationgateways.ApplicationGatewayPropertiesFormat{}
 	}
 
+	// take a copy of the existing properties, since any child items managed by a separate resource (for example
+	// `azurerm_application_gateway_probe`) need to be retained when they're listed in `ignore_externally_managed_children`
+	existingProperties := *payload.Properties
+
 	if d.HasChange("enable_http2") {
 		payload.Properties.EnableHTTP2 = pointer.To(d.Get("enable_http2").(bool))
 	}
@@ -1770,6 +1792,11 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		if err != nil {
 			return fmt.Errorf("expanding `request_routing_rule`: %+v", err)
 		}
+		for _, v := range pointer.From(existingProperties.RequestRoutingRules) {
+			if isExternallyManagedApplicationGatewayChild(d, "request_routing_rule", v.Name) {
+				*requestRoutingRules = append(*requestRoutingRules, v)
+			}
+		}
 		payload.Properties.RequestRoutingRules = requestRoutingRules
 	}
 
@@ -1796,6 +1823,11 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		if err != nil {
 			return fmt.Errorf("expanding `ssl_certificate`: %+v", err)
 		}
+		for _, v := range pointer.From(existingProperties.SslCertificates) {
+			if isExternallyManagedApplicationGatewayChild(d, "ssl_certificate", v.Name) {
+				*sslCertificates = append(*sslCertificates, v)
+			}
+		}
 
 		payload.Properties.SslCertificates = sslCertificates
 	}
@@ -1828,6 +1860,11 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		if err != nil {
 			return fmt.Errorf("fail to expand `http_listener`: %+v", err)
 		}
+		for _, v := range pointer.From(existingProperties.HTTPListeners) {
+			if isExternallyManagedApplicationGatewayChild(d, "http_listener", v.Name) {
+				*httpListeners = append(*httpListeners, v)
+			}
+		}
 
 		payload.Properties.HTTPListeners = httpListeners
 	}
@@ -1854,7 +1891,13 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("backend_address_pool") {
-		payload.Properties.BackendAddressPools = expandApplicationGatewayBackendAddressPools(d)
+		backendAddressPools := expandApplicationGatewayBackendAddressPools(d)
+		for _, v := range pointer.From(existingProperties.BackendAddressPools) {
+			if isExternallyManagedApplicationGatewayChild(d, "backend_address_pool", v.Name) {
+				*backendAddressPools = append(*backendAddressPools, v)
+			}
+		}
+		payload.Properties.BackendAddressPools = backendAddressPools
 	}
 
 	if d.HasChange("backend_http_settings") {
@@ -1874,7 +1917,13 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("probe") {
-		payload.Properties.Probes = expandApplicationGatewayProbes(d)
+		probes := expandApplicationGatewayProbes(d)
+		for _, v := range pointer.From(existingProperties.Probes) {
+			if isExternallyManagedApplicationGatewayChild(d, "probe", v.Name) {
+				*probes = append(*probes, v)
+			}
+		}
+		payload.Properties.Probes = probes
 	}
 
 	if d.HasChange("sku") {
@@ -2028,7 +2077,8 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `trusted_root_certificate`: %+v", err)
 			}
 
-			if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
+			backendAddressPools := withoutExternallyManagedApplicationGatewayChildren(d, "backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools))
+			if setErr := d.Set("backend_address_pool", backendAddressPools); setErr != nil {
 				return fmt.Errorf("setting `backend_address_pool`: %+v", setErr)
 			}
 
@@ -2052,6 +2102,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			if err != nil {
 				return fmt.Errorf("flattening `http_listener`: %+v", err)
 			}
+			httpListeners = withoutExternallyManagedApplicationGatewayChildren(d, "http_listener", httpListeners)
 			if setErr := d.Set("http_listener", httpListeners); setErr != nil {
 				return fmt.Errorf("setting `http_listener`: %+v", setErr)
 			}
@@ -2084,7 +2135,8 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `private_link_configuration`: %+v", setErr)
 			}
 
-			if setErr := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); setErr != nil {
+			probes := withoutExternallyManagedApplicationGatewayChildren(d, "probe", flattenApplicationGatewayProbes(props.Probes))
+			if setErr := d.Set("probe", probes); setErr != nil {
 				return fmt.Errorf("setting `probe`: %+v", setErr)
 			}
 
@@ -2092,6 +2144,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			if err != nil {
 				return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
 			}
+			requestRoutingRules = withoutExternallyManagedApplicationGatewayChildren(d, "request_routing_rule", requestRoutingRules)
 			if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
 				return fmt.Errorf("setting `request_routing_rule`: %+v", setErr)
 			}
@@ -2117,7 +2170,8 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `autoscale_configuration`: %+v", setErr)
 			}
 
-			if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); setErr != nil {
+			sslCertificates := withoutExternallyManagedApplicationGatewayChildren(d, "ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d))
+			if setErr := d.Set("ssl_certificate", sslCertificates); setErr != nil {
 				return fmt.Errorf("setting `ssl_certificate`: %+v", setErr)
 			}
 
@@ -2174,6 +2228,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
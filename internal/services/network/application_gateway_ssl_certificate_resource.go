@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-07-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewaySslCertificateModel struct {
+	Name                 string `tfschema:"name"`
+	ApplicationGatewayId string `tfschema:"application_gateway_id"`
+	Data                 string `tfschema:"data"`
+	Password             string `tfschema:"password"`
+	KeyVaultSecretId     string `tfschema:"key_vault_secret_id"`
+	PublicCertData       string `tfschema:"public_cert_data"`
+}
+
+type ApplicationGatewaySslCertificateResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewaySslCertificateResource{}
+
+func (r ApplicationGatewaySslCertificateResource) ResourceType() string {
+	return "azurerm_application_gateway_ssl_certificate"
+}
+
+func (r ApplicationGatewaySslCertificateResource) ModelObject() interface{} {
+	return &ApplicationGatewaySslCertificateModel{}
+}
+
+func (r ApplicationGatewaySslCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkValidate.SslCertificateID
+}
+
+func (r ApplicationGatewaySslCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationgateways.ValidateApplicationGatewayID,
+		},
+
+		"data": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			StateFunc:    base64EncodedStateFunc,
+			ValidateFunc: validation.StringIsBase64,
+			ExactlyOneOf: []string{"data", "key_vault_secret_id"},
+		},
+
+		"password": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"key_vault_secret_id"},
+		},
+
+		"key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			ExactlyOneOf: []string{"data", "key_vault_secret_id"},
+		},
+	}
+}
+
+func (r ApplicationGatewaySslCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"public_cert_data": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApplicationGatewaySslCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			var model ApplicationGatewaySslCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId, err := applicationgateways.ParseApplicationGatewayID(model.ApplicationGatewayId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSslCertificateID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, model.Name)
+
+			err = updateApplicationGateway(ctx, client, *gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				certificates := pointer.From(props.SslCertificates)
+				for _, v := range certificates {
+					if pointer.From(v.Name) == id.Name {
+						return metadata.ResourceRequiresImport(r.ResourceType(), id)
+					}
+				}
+
+				props.SslCertificates = pointer.To(append(certificates, expandApplicationGatewaySslCertificateModel(model)))
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewaySslCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.SslCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			resp, err := client.Get(ctx, gatewayId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+			}
+
+			var certificate *applicationgateways.ApplicationGatewaySslCertificate
+			if model := resp.Model; model != nil && model.Properties != nil {
+				for _, v := range pointer.From(model.Properties.SslCertificates) {
+					if pointer.From(v.Name) == id.Name {
+						certificate = pointer.To(v)
+						break
+					}
+				}
+			}
+			if certificate == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			// the certificate data and password aren't returned by the API, so these are loaded from the state
+			var existing ApplicationGatewaySslCertificateModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApplicationGatewaySslCertificateModel{
+				Name:                 id.Name,
+				ApplicationGatewayId: gatewayId.ID(),
+				Password:             existing.Password,
+			}
+
+			if existing.Data != "" {
+				state.Data = utils.Base64EncodeIfNot(existing.Data)
+			}
+
+			if props := certificate.Properties; props != nil {
+				state.KeyVaultSecretId = pointer.From(props.KeyVaultSecretId)
+				state.PublicCertData = pointer.From(props.PublicCertData)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationGatewaySslCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.SslCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationGatewaySslCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				certificates := pointer.From(props.SslCertificates)
+				for i, v := range certificates {
+					if pointer.From(v.Name) == id.Name {
+						certificates[i] = expandApplicationGatewaySslCertificateModel(model)
+						props.SslCertificates = pointer.To(certificates)
+						return nil
+					}
+				}
+
+				return fmt.Errorf("%s was not found", id)
+			})
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationGatewaySslCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGatewaysClient
+
+			id, err := parse.SslCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+			err = updateApplicationGateway(ctx, client, gatewayId, func(props *applicationgateways.ApplicationGatewayPropertiesFormat) error {
+				certificates := make([]applicationgateways.ApplicationGatewaySslCertificate, 0)
+				for _, v := range pointer.From(props.SslCertificates) {
+					if pointer.From(v.Name) != id.Name {
+						certificates = append(certificates, v)
+					}
+				}
+				props.SslCertificates = pointer.To(certificates)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationGatewaySslCertificateModel(input ApplicationGatewaySslCertificateModel) applicationgateways.ApplicationGatewaySslCertificate {
+	output := applicationgateways.ApplicationGatewaySslCertificate{
+		Name:       pointer.To(input.Name),
+		Properties: &applicationgateways.ApplicationGatewaySslCertificatePropertiesFormat{},
+	}
+
+	if input.Data != "" {
+		output.Properties.Data = pointer.To(utils.Base64EncodeIfNot(input.Data))
+		output.Properties.Password = pointer.To(input.Password)
+	}
+
+	if input.KeyVaultSecretId != "" {
+		output.Properties.KeyVaultSecretId = pointer.To(input.KeyVaultSecretId)
+	}
+
+	return output
+}
@@ -104,7 +104,7 @@ func resourceApplicationSecurityGroupUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("retrieving %s: `model` was nil", id)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		existing.Model.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/connectivityconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkgroups"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -94,7 +96,7 @@ func (r ManagerConnectivityConfigurationResource) Arguments() map[string]*plugin
 					"network_group_id": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&networkgroups.NetworkGroupId{})),
 					},
 
 					"use_hub_gateway": {
@@ -134,7 +136,7 @@ func (r ManagerConnectivityConfigurationResource) Arguments() map[string]*plugin
 					"resource_id": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.VirtualNetworkId{})),
 					},
 
 					"resource_type": {
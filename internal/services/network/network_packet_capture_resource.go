@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/packetcaptures"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -103,6 +104,7 @@ func resourceNetworkPacketCapture() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							AtLeastOneOf: []string{"storage_location.0.file_path", "storage_location.0.storage_account_id"},
+							ValidateFunc: validation.ResourceIDOfType(&commonids.StorageAccountId{}),
 						},
 						"storage_path": {
 							Type:     pluginsdk.TypeString,
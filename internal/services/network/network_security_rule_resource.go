@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/applicationsecuritygroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/securityrules"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -134,8 +135,11 @@ func resourceNetworkSecurityRule() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				MaxItems: 10,
 				Optional: true,
-				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-				Set:      pluginsdk.HashString,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.ResourceIDOfType(&applicationsecuritygroups.ApplicationSecurityGroupId{}),
+				},
+				Set: pluginsdk.HashString,
 			},
 
 			//lintignore:S018
@@ -143,8 +147,11 @@ func resourceNetworkSecurityRule() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				MaxItems: 10,
 				Optional: true,
-				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-				Set:      pluginsdk.HashString,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.ResourceIDOfType(&applicationsecuritygroups.ApplicationSecurityGroupId{}),
+				},
+				Set: pluginsdk.HashString,
 			},
 
 			"access": {
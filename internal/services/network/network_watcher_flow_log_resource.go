@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/flowlogs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkwatchers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -71,11 +72,29 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 				ValidateFunc: validate.NetworkWatcherFlowLogName,
 			},
 
+			// a Network Security Group can be specified using either field, as such both are Computed so that
+			// there's no diff for the field which isn't specified
 			"network_security_group_id": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validate.NetworkSecurityGroupID,
+				ExactlyOneOf: []string{"network_security_group_id", "target_resource_id"},
+			},
+
+			"target_resource_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					commonids.ValidateVirtualNetworkID,
+					commonids.ValidateSubnetID,
+					commonids.ValidateNetworkInterfaceID,
+					validate.NetworkSecurityGroupID,
+				),
+				ExactlyOneOf: []string{"network_security_group_id", "target_resource_id"},
 			},
 
 			"storage_account_id": {
@@ -135,9 +154,12 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 						},
 
 						"workspace_resource_id": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceIDOrEmpty, // nolint: staticcheck
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								azure.ValidateResourceIDOrEmpty, // nolint: staticcheck
+								validation.ResourceIDOfTypeOrEmpty(&workspaces.WorkspaceId{}),
+							),
 						},
 
 						"interval_in_minutes": {
@@ -191,7 +213,7 @@ func resourceNetworkWatcherFlowLogCreate(d *pluginsdk.ResourceData, meta interfa
 	defer cancel()
 
 	id := flowlogs.NewFlowLogID(subscriptionId, d.Get("resource_group_name").(string), d.Get("network_watcher_name").(string), d.Get("name").(string))
-	nsgId, err := parse.NetworkSecurityGroupID(d.Get("network_security_group_id").(string))
+	targetResourceId, err := networkWatcherFlowLogTargetResourceIdFromConfig(d)
 	if err != nil {
 		return err
 	}
@@ -212,8 +234,8 @@ func resourceNetworkWatcherFlowLogCreate(d *pluginsdk.ResourceData, meta interfa
 		return tf.ImportAsExistsError("azurerm_network_watcher_flow_log", id.ID())
 	}
 
-	locks.ByID(nsgId.ID())
-	defer locks.UnlockByID(nsgId.ID())
+	locks.ByID(targetResourceId)
+	defer locks.UnlockByID(targetResourceId)
 
 	loc := d.Get("location").(string)
 	if loc == "" {
@@ -232,7 +254,7 @@ func resourceNetworkWatcherFlowLogCreate(d *pluginsdk.ResourceData, meta interfa
 	parameters := flowlogs.FlowLog{
 		Location: utils.String(location.Normalize(loc)),
 		Properties: &flowlogs.FlowLogPropertiesFormat{
-			TargetResourceId: nsgId.ID(),
+			TargetResourceId: targetResourceId,
 			StorageId:        d.Get("storage_account_id").(string),
 			Enabled:          pointer.To(d.Get("enabled").(bool)),
 			RetentionPolicy:  expandNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
@@ -285,12 +307,12 @@ func resourceNetworkWatcherFlowLogUpdate(d *pluginsdk.ResourceData, meta interfa
 
 	payload := existing.Model
 
-	nsgId, err := parse.NetworkSecurityGroupID(d.Get("network_security_group_id").(string))
+	targetResourceId, err := networkWatcherFlowLogTargetResourceIdFromConfig(d)
 	if err != nil {
 		return err
 	}
-	locks.ByID(nsgId.ID())
-	defer locks.UnlockByID(nsgId.ID())
+	locks.ByID(targetResourceId)
+	defer locks.UnlockByID(targetResourceId)
 
 	if d.HasChange("storage_account_id") {
 		payload.Properties.StorageId = d.Get("storage_account_id").(string)
@@ -373,12 +395,18 @@ func resourceNetworkWatcherFlowLogRead(d *pluginsdk.ResourceData, meta interface
 				d.Set("storage_account_id", props.StorageId)
 			}
 
+			// `target_resource_id` is always set, whereas `network_security_group_id` is only set when the target
+			// is a Network Security Group - since either field can be used to specify a Network Security Group
+			targetResourceId := ""
+			if v, err := parseNetworkWatcherFlowLogTargetResourceId(props.TargetResourceId); err == nil {
+				targetResourceId = *v
+			}
 			networkSecurityGroupId := ""
-			nsgId, err := parse.NetworkSecurityGroupIDInsensitively(props.TargetResourceId)
-			if err == nil {
+			if nsgId, err := parse.NetworkSecurityGroupIDInsensitively(props.TargetResourceId); err == nil {
 				networkSecurityGroupId = nsgId.ID()
 			}
 			d.Set("network_security_group_id", networkSecurityGroupId)
+			d.Set("target_resource_id", targetResourceId)
 
 			if err := d.Set("retention_policy", flattenNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
 				return fmt.Errorf("setting `retention_policy`: %+v", err)
@@ -409,13 +437,13 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("retreiving %s: `properties` or `properties.TargetResourceID` was nil", id)
 	}
 
-	networkSecurityGroupId, err := parse.NetworkSecurityGroupIDInsensitively(resp.Model.Properties.TargetResourceId)
+	targetResourceId, err := parseNetworkWatcherFlowLogTargetResourceId(resp.Model.Properties.TargetResourceId)
 	if err != nil {
-		return fmt.Errorf("parsing %q as a Network Security Group ID: %+v", resp.Model.Properties.TargetResourceId, err)
+		return err
 	}
 
-	locks.ByID(networkSecurityGroupId.ID())
-	defer locks.UnlockByID(networkSecurityGroupId.ID())
+	locks.ByID(*targetResourceId)
+	defer locks.UnlockByID(*targetResourceId)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %v", id, err)
@@ -424,6 +452,41 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 	return nil
 }
 
+func networkWatcherFlowLogTargetResourceIdFromConfig(d *pluginsdk.ResourceData) (string, error) {
+	if v := d.Get("network_security_group_id").(string); v != "" {
+		nsgId, err := parse.NetworkSecurityGroupID(v)
+		if err != nil {
+			return "", err
+		}
+		return nsgId.ID(), nil
+	}
+
+	targetResourceId, err := parseNetworkWatcherFlowLogTargetResourceId(d.Get("target_resource_id").(string))
+	if err != nil {
+		return "", err
+	}
+	return *targetResourceId, nil
+}
+
+// parseNetworkWatcherFlowLogTargetResourceId parses the Resource ID of the Virtual Network, Subnet, Network Interface
+// or Network Security Group which a Flow Log is targeting, returning the normalized Resource ID
+func parseNetworkWatcherFlowLogTargetResourceId(input string) (*string, error) {
+	if subnetId, err := commonids.ParseSubnetIDInsensitively(input); err == nil {
+		return pointer.To(subnetId.ID()), nil
+	}
+	if virtualNetworkId, err := commonids.ParseVirtualNetworkIDInsensitively(input); err == nil {
+		return pointer.To(virtualNetworkId.ID()), nil
+	}
+	if networkInterfaceId, err := commonids.ParseNetworkInterfaceIDInsensitively(input); err == nil {
+		return pointer.To(networkInterfaceId.ID()), nil
+	}
+	if networkSecurityGroupId, err := parse.NetworkSecurityGroupIDInsensitively(input); err == nil {
+		return pointer.To(networkSecurityGroupId.ID()), nil
+	}
+
+	return nil, fmt.Errorf("expected the target resource %q to be a Virtual Network, Subnet, Network Interface or Network Security Group ID", input)
+}
+
 func expandNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *flowlogs.RetentionPolicyParameters {
 	if len(input) < 1 || input[0] == nil {
 		return nil
@@ -107,7 +107,7 @@ func resourceNetworkWatcherUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	payload := existing.Model
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -77,16 +77,19 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"machineScope":               testAccVirtualMachineScaleSetPacketCapture_machineScope,
 		},
 		"FlowLog": {
-			"basic":                testAccNetworkWatcherFlowLog_basic,
-			"requiresImport":       testAccNetworkWatcherFlowLog_requiresImport,
-			"disabled":             testAccNetworkWatcherFlowLog_disabled,
-			"reenabled":            testAccNetworkWatcherFlowLog_reenabled,
-			"retentionPolicy":      testAccNetworkWatcherFlowLog_retentionPolicy,
-			"updateStorageAccount": testAccNetworkWatcherFlowLog_updateStorageAccount,
-			"trafficAnalytics":     testAccNetworkWatcherFlowLog_trafficAnalytics,
-			"version":              testAccNetworkWatcherFlowLog_version,
-			"location":             testAccNetworkWatcherFlowLog_location,
-			"tags":                 testAccNetworkWatcherFlowLog_tags,
+			"basic":                      testAccNetworkWatcherFlowLog_basic,
+			"requiresImport":             testAccNetworkWatcherFlowLog_requiresImport,
+			"disabled":                   testAccNetworkWatcherFlowLog_disabled,
+			"reenabled":                  testAccNetworkWatcherFlowLog_reenabled,
+			"retentionPolicy":            testAccNetworkWatcherFlowLog_retentionPolicy,
+			"updateStorageAccount":       testAccNetworkWatcherFlowLog_updateStorageAccount,
+			"trafficAnalytics":           testAccNetworkWatcherFlowLog_trafficAnalytics,
+			"version":                    testAccNetworkWatcherFlowLog_version,
+			"location":                   testAccNetworkWatcherFlowLog_location,
+			"tags":                       testAccNetworkWatcherFlowLog_tags,
+			"targetVirtualNetwork":       testAccNetworkWatcherFlowLog_targetVirtualNetwork,
+			"targetSubnet":               testAccNetworkWatcherFlowLog_targetSubnet,
+			"targetNetworkSecurityGroup": testAccNetworkWatcherFlowLog_targetNetworkSecurityGroup,
 		},
 	}
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/loadbalancers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -141,7 +142,7 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 				ForceNew: true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
-					ValidateFunc: azure.ValidateResourceID,
+					ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&loadbalancers.FrontendIPConfigurationId{})),
 				},
 				Set: pluginsdk.HashString,
 			},
@@ -145,7 +145,7 @@ func resourcePublicIpPrefixUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 
 		id, err := publicipprefixes.ParsePublicIPPrefixID(d.Id())
 		if err != nil {
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/publicipaddresses"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/usages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/quota"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// publicIpQuotaCustomizeDiff is opted into using the `quota` block within the Provider `features` block, and totals
+// the Public IP Addresses created within the plan so that exceeding the approved quota is surfaced during the plan
+func publicIpQuotaCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	if !client.Features.Quota.ValidateDuringPlan {
+		return nil
+	}
+
+	// all of the fields used below are ForceNew, so only new Public IP Addresses consume additional quota
+	if d.Id() != "" {
+		return nil
+	}
+
+	// values which aren't known until apply can't be totalled
+	for _, field := range []string{"name", "resource_group_name", "location", "sku", "allocation_method"} {
+		if !d.NewValueKnown(field) {
+			return nil
+		}
+	}
+
+	amounts := map[string]int64{
+		"PublicIPAddresses": 1,
+	}
+	if d.Get("sku").(string) == string(publicipaddresses.PublicIPAddressSkuNameStandard) {
+		amounts["StandardSkuPublicIpAddresses"] = 1
+	}
+	if d.Get("allocation_method").(string) == string(publicipaddresses.IPAllocationMethodStatic) {
+		amounts["StaticPublicIPAddresses"] = 1
+	}
+
+	subscriptionId := client.Account.SubscriptionId
+	location := d.Get("location").(string)
+	change := quota.Change{
+		ResourceId: fmt.Sprintf("azurerm_public_ip/%s/%s", d.Get("resource_group_name").(string), d.Get("name").(string)),
+		Scope: quota.Scope{
+			SubscriptionId: subscriptionId,
+			Location:       location,
+			Provider:       "Microsoft.Network",
+		},
+		Amounts: amounts,
+	}
+
+	return quota.Check(ctx, change, func(ctx context.Context) ([]quota.Usage, error) {
+		resp, err := client.Network.Usages.ListComplete(ctx, usages.NewLocationID(subscriptionId, location))
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]quota.Usage, 0)
+		for _, v := range resp.Items {
+			result = append(result, quota.Usage{
+				Name:         pointer.From(v.Name.Value),
+				DisplayName:  pointer.From(v.Name.LocalizedValue),
+				CurrentValue: v.CurrentValue,
+				Limit:        v.Limit,
+			})
+		}
+		return result, nil
+	})
+}
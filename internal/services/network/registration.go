@@ -41,6 +41,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApplicationGatewayBackendAddressPoolResource{},
+		ApplicationGatewayHttpListenerResource{},
+		ApplicationGatewayProbeResource{},
+		ApplicationGatewayRequestRoutingRuleResource{},
+		ApplicationGatewaySslCertificateResource{},
 		CustomIpPrefixResource{},
 		ManagerAdminRuleResource{},
 		ManagerAdminRuleCollectionResource{},
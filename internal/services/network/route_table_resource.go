@@ -184,7 +184,7 @@ func resourceRouteTableUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		payload.Properties.DisableBgpRoutePropagation = pointer.To(d.Get("disable_bgp_route_propagation").(bool))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -167,7 +167,7 @@ func resourceVirtualHubSecurityPartnerProviderUpdate(d *pluginsdk.ResourceData,
 
 	parameters := securitypartnerproviders.TagsObject{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -575,7 +575,7 @@ func resourceWebApplicationFirewallPolicyUpdate(d *pluginsdk.ResourceData, meta
 		model.Properties.ManagedRules = pointer.From(expandedManagedRules)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		model.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
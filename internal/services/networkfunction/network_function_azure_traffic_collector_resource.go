@@ -146,7 +146,7 @@ func (r NetworkFunctionAzureTrafficCollectorResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("retrieving %s: properties was nil", id)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
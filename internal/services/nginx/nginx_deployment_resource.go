@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-01-01-preview/nginxconfiguration"
@@ -219,8 +220,9 @@ func (m DeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 					},
 
 					"subnet_id": {
-						Type:     pluginsdk.TypeString,
-						Required: true,
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.ResourceIDOfType(&commonids.SubnetId{}),
 					},
 				},
 			},
@@ -233,8 +235,9 @@ func (m DeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"subnet_id": {
-						Type:     pluginsdk.TypeString,
-						Required: true,
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.ResourceIDOfType(&commonids.SubnetId{}),
 					},
 				},
 			},
@@ -657,7 +660,7 @@ func (m DeploymentResource) Update() sdk.ResourceFunc {
 				req.Sku = &nginxdeployment.ResourceSku{Name: model.Sku}
 			}
 
-			if meta.ResourceData.HasChange("tags") {
+			if meta.ResourceData.HasChanges("tags", "tags_all") {
 				req.Tags = pointer.FromMapOfStringStrings(model.Tags)
 			}
 
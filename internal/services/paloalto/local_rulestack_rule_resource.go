@@ -489,7 +489,7 @@ func (r LocalRuleStackRule) Update() sdk.ResourceFunc {
 				ruleEntry.Properties.Source = source
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				ruleEntry.Properties.Tags = expandTagsForRule(model.Tags)
 			}
 
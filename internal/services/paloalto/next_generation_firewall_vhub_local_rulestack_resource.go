@@ -281,7 +281,7 @@ func (r NextGenerationFirewallVHubLocalRuleStackResource) Update() sdk.ResourceF
 
 			firewall.Properties = props
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				firewall.Tags = tags.Expand(model.Tags)
 			}
 
@@ -276,7 +276,7 @@ func (r NextGenerationFirewallVNetLocalRulestackResource) Update() sdk.ResourceF
 
 			firewall.Properties = props
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				firewall.Tags = tags.Expand(model.Tags)
 			}
 
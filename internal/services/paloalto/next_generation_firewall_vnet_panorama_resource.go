@@ -259,7 +259,7 @@ func (r NextGenerationFirewallVNetPanoramaResource) Update() sdk.ResourceFunc {
 
 			firewall.Properties = props
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				firewall.Tags = tags.Expand(model.Tags)
 			}
 
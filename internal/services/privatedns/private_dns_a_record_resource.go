@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -127,7 +128,13 @@ func resourcePrivateDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta inter
 	}
 
 	d.SetId(id.ID())
-	return resourcePrivateDnsARecordRead(d, meta)
+
+	// changes to the records aren't always returned immediately
+	return sdk.ReadUntilConsistent(ctx, d, sdk.ConsistencyCheck{
+		Fields: []string{"records", "ttl"},
+	}, func() error {
+		return resourcePrivateDnsARecordRead(d, meta)
+	})
 }
 
 func resourcePrivateDnsARecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
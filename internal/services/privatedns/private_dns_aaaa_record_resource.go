@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -123,7 +124,13 @@ func resourcePrivateDnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	d.SetId(id.ID())
-	return resourcePrivateDnsAaaaRecordRead(d, meta)
+
+	// changes to the records aren't always returned immediately
+	return sdk.ReadUntilConsistent(ctx, d, sdk.ConsistencyCheck{
+		Fields: []string{"records", "ttl"},
+	}, func() error {
+		return resourcePrivateDnsAaaaRecordRead(d, meta)
+	})
 }
 
 func resourcePrivateDnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
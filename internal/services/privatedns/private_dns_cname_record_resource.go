@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -128,7 +129,13 @@ func resourcePrivateDnsCNameRecordCreateUpdate(d *pluginsdk.ResourceData, meta i
 	}
 
 	d.SetId(id.ID())
-	return resourcePrivateDnsCNameRecordRead(d, meta)
+
+	// changes to the records aren't always returned immediately
+	return sdk.ReadUntilConsistent(ctx, d, sdk.ConsistencyCheck{
+		Fields: []string{"record", "ttl"},
+	}, func() error {
+		return resourcePrivateDnsCNameRecordRead(d, meta)
+	})
 }
 
 func resourcePrivateDnsCNameRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -143,7 +144,13 @@ func resourcePrivateDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	d.SetId(id.ID())
-	return resourcePrivateDnsMxRecordRead(d, meta)
+
+	// changes to the records aren't always returned immediately
+	return sdk.ReadUntilConsistent(ctx, d, sdk.ConsistencyCheck{
+		Fields: []string{"record", "ttl"},
+	}, func() error {
+		return resourcePrivateDnsMxRecordRead(d, meta)
+	})
 }
 
 func resourcePrivateDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
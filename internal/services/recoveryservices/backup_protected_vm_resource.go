@@ -544,9 +544,12 @@ func resourceRecoveryServicesBackupProtectedVMSchema() map[string]*pluginsdk.Sch
 			Optional: true,
 			Computed: true,
 			ForceNew: true,
-			ValidateFunc: validation.Any(
-				validation.StringIsEmpty,
-				azure.ValidateResourceID,
+			ValidateFunc: validation.All(
+				validation.Any(
+					validation.StringIsEmpty,
+					azure.ValidateResourceID,
+				),
+				validation.ResourceIDOfTypeOrEmpty(&commonids.VirtualMachineId{}),
 			),
 			// TODO: make this case sensitive once the API's fixed https://github.com/Azure/azure-rest-api-specs/issues/10357
 			DiffSuppressFunc: suppress.CaseDifference,
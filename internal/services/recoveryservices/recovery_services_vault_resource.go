@@ -472,7 +472,7 @@ func resourceRecoveryServicesVaultUpdate(d *pluginsdk.ResourceData, meta interfa
 		vault.Properties.Encryption = encryption
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		vault.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationfabrics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationnetworkmappings"
@@ -72,7 +73,7 @@ func (s HyperVNetworkMappingResource) Arguments() map[string]*schema.Schema {
 			Type:             pluginsdk.TypeString,
 			Required:         true,
 			ForceNew:         true,
-			ValidateFunc:     azure.ValidateResourceID,
+			ValidateFunc:     validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.VirtualNetworkId{})),
 			DiffSuppressFunc: suppress.CaseDifference,
 		},
 	}
@@ -69,14 +69,14 @@ func resourceSiteRecoveryNetworkMapping() *pluginsdk.Resource {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     azure.ValidateResourceID,
+				ValidateFunc:     validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.VirtualNetworkId{})),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 			"target_network_id": {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     azure.ValidateResourceID,
+				ValidateFunc:     validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.VirtualNetworkId{})),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 		},
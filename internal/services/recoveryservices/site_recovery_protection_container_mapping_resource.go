@@ -10,7 +10,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/automationaccount"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotectioncontainermappings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotectioncontainers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -67,7 +70,7 @@ func resourceSiteRecoveryProtectionContainerMapping() *pluginsdk.Resource {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     azure.ValidateResourceID,
+				ValidateFunc:     validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&replicationpolicies.ReplicationPolicyId{})),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 			"recovery_source_protection_container_name": {
@@ -80,7 +83,7 @@ func resourceSiteRecoveryProtectionContainerMapping() *pluginsdk.Resource {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     azure.ValidateResourceID,
+				ValidateFunc:     validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&replicationprotectioncontainers.ReplicationProtectionContainerId{})),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 			"automatic_update": {
@@ -101,7 +104,7 @@ func resourceSiteRecoveryProtectionContainerMapping() *pluginsdk.Resource {
 						"automation_account_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&automationaccount.AutomationAccountId{})),
 						},
 
 						"authentication_type": {
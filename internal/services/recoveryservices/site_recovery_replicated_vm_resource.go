@@ -86,7 +86,7 @@ func resourceSiteRecoveryReplicatedVM() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.VirtualMachineId{})),
 				// user-specified segments are lower cased too.
 				// tracked on https://github.com/Azure/azure-rest-api-specs/issues/24393
 				DiffSuppressFunc: suppress.CaseDifference,
@@ -172,7 +172,7 @@ func resourceSiteRecoveryReplicatedVM() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.StorageAccountId{})),
 						},
 
 						"target_storage_account_id": {
@@ -203,7 +203,7 @@ func resourceSiteRecoveryReplicatedVM() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&commonids.ManagedDiskId{})),
 						},
 
 						"staging_storage_account_id": {
@@ -304,7 +304,7 @@ func networkInterfaceResource() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Computed:     true,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.NetworkInterfaceId{})),
 			},
 
 			"failover_test_static_ip": {
@@ -342,14 +342,14 @@ func networkInterfaceResource() *pluginsdk.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     false,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.PublicIPAddressId{})),
 			},
 
 			"recovery_public_ip_address_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     false,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.PublicIPAddressId{})),
 			},
 		},
 	}
@@ -208,7 +208,7 @@ func (r RedHatOpenShiftCluster) Arguments() map[string]*pluginsdk.Schema {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						ForceNew:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.SubnetId{})),
 					},
 					"vm_size": {
 						Type:             pluginsdk.TypeString,
@@ -227,7 +227,7 @@ func (r RedHatOpenShiftCluster) Arguments() map[string]*pluginsdk.Schema {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						ForceNew:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.DiskEncryptionSetId{})),
 					},
 				},
 			},
@@ -263,7 +263,7 @@ func (r RedHatOpenShiftCluster) Arguments() map[string]*pluginsdk.Schema {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						ForceNew:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.SubnetId{})),
 					},
 					"encryption_at_host_enabled": {
 						Type:     pluginsdk.TypeBool,
@@ -275,7 +275,7 @@ func (r RedHatOpenShiftCluster) Arguments() map[string]*pluginsdk.Schema {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						ForceNew:     true,
-						ValidateFunc: azure.ValidateResourceID,
+						ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.DiskEncryptionSetId{})),
 					},
 				},
 			},
@@ -425,7 +425,7 @@ func (r RedHatOpenShiftCluster) Update() sdk.ResourceFunc {
 
 			parameter := openshiftclusters.OpenShiftClusterUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				parameter.Tags = pointer.To(state.Tags)
 			}
 
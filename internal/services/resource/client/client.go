@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	resourceGraph "github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2022-10-01/resources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/privatelinkassociation"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
@@ -26,6 +27,7 @@ type Client struct {
 	PrivateLinkAssociationClient        *privatelinkassociation.PrivateLinkAssociationClient
 	ResourceGroupsClient                *resourcegroups.ResourceGroupsClient
 	ResourceManagementPrivateLinkClient *resourcemanagementprivatelink.ResourceManagementPrivateLinkClient
+	ResourceGraphClient                 *resourceGraph.ResourcesClient
 	ResourceProvidersClient             *providers.ProvidersClient
 	TemplateSpecsVersionsClient         *templatespecversions.TemplateSpecVersionsClient
 	TagsClient                          *tags.TagsClient
@@ -77,6 +79,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(resourceManagementPrivateLinkClient.Client, o.Authorizers.ResourceManager)
 
+	resourceGraphClient, err := resourceGraph.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ResourceGraph client: %+v", err)
+	}
+	o.Configure(resourceGraphClient.Client, o.Authorizers.ResourceManager)
+
 	resourceProvidersClient, err := providers.NewProvidersClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Providers client: %+v", err)
@@ -111,6 +119,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		LocksClient:                         locksClient,
 		PrivateLinkAssociationClient:        privateLinkAssociationClient,
 		ResourceManagementPrivateLinkClient: resourceManagementPrivateLinkClient,
+		ResourceGraphClient:                 resourceGraphClient,
 		ResourceGroupsClient:                resourceGroupsClient,
 		ResourceProvidersClient:             resourceProvidersClient,
 		TemplateSpecsVersionsClient:         templateSpecsVersionsClient,
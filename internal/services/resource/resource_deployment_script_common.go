@@ -267,7 +267,7 @@ func updateDeploymentScript() sdk.ResourceFunc {
 
 			properties := &deploymentscripts.DeploymentScriptUpdateParameter{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				tagValue := make(map[string]string)
 				if model.Tags != nil {
 					tagValue = model.Tags
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	resourceGraph "github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2022-10-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// resourceGraphQueryPageSize is the maximum number of rows which can be returned by Resource Graph in a single page
+const resourceGraphQueryPageSize = 1000
+
+var _ sdk.DataSource = ResourceGraphQueryDataSource{}
+
+type ResourceGraphQueryDataSource struct{}
+
+type ResourceGraphQueryDataSourceModel struct {
+	Query              string                          `tfschema:"query"`
+	SubscriptionIds    []string                        `tfschema:"subscription_ids"`
+	ManagementGroupIds []string                        `tfschema:"management_group_ids"`
+	Results            []ResourceGraphQueryResultModel `tfschema:"results"`
+	ResultsJson        string                          `tfschema:"results_json"`
+	TotalRecords       int64                           `tfschema:"total_records"`
+}
+
+type ResourceGraphQueryResultModel struct {
+	Id                string            `tfschema:"id"`
+	Name              string            `tfschema:"name"`
+	Type              string            `tfschema:"type"`
+	Location          string            `tfschema:"location"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	SubscriptionId    string            `tfschema:"subscription_id"`
+	Tags              map[string]string `tfschema:"tags"`
+	Json              string            `tfschema:"json"`
+}
+
+func (ResourceGraphQueryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subscription_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+			ConflictsWith: []string{"management_group_ids"},
+		},
+
+		"management_group_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: commonids.ValidateManagementGroupID,
+			},
+			ConflictsWith: []string{"subscription_ids"},
+		},
+	}
+}
+
+func (ResourceGraphQueryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"results": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"location": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"resource_group_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"subscription_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tags": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"json": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"results_json": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"total_records": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (ResourceGraphQueryDataSource) ModelObject() interface{} {
+	return &ResourceGraphQueryDataSourceModel{}
+}
+
+func (ResourceGraphQueryDataSource) ResourceType() string {
+	return "azurerm_resource_graph_query"
+}
+
+func (ResourceGraphQueryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourceGraphClient
+
+			var state ResourceGraphQueryDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			input := resourceGraph.QueryRequest{
+				Query: state.Query,
+				Options: &resourceGraph.QueryRequestOptions{
+					ResultFormat: pointer.To(resourceGraph.ResultFormatObjectArray),
+					Top:          pointer.To(int64(resourceGraphQueryPageSize)),
+				},
+			}
+
+			scopes := make([]string, 0)
+			switch {
+			case len(state.ManagementGroupIds) > 0:
+				managementGroups := make([]string, 0)
+				for _, v := range state.ManagementGroupIds {
+					id, err := commonids.ParseManagementGroupID(v)
+					if err != nil {
+						return err
+					}
+					managementGroups = append(managementGroups, id.GroupId)
+				}
+				input.ManagementGroups = &managementGroups
+				scopes = append(scopes, state.ManagementGroupIds...)
+
+			case len(state.SubscriptionIds) > 0:
+				input.Subscriptions = pointer.To(state.SubscriptionIds)
+				scopes = append(scopes, state.SubscriptionIds...)
+
+			default:
+				// when no scope is specified we default to the Subscription the Provider is configured for,
+				// rather than every Subscription the credentials have access to
+				subscriptionId := metadata.Client.Account.SubscriptionId
+				input.Subscriptions = &[]string{subscriptionId}
+				scopes = append(scopes, subscriptionId)
+			}
+
+			rows := make([]interface{}, 0)
+			for {
+				resp, err := client.Resources(ctx, input)
+				if err != nil {
+					return fmt.Errorf("running Resource Graph query: %+v", err)
+				}
+				if resp.Model == nil {
+					return fmt.Errorf("running Resource Graph query: `model` was nil")
+				}
+
+				page, ok := resp.Model.Data.([]interface{})
+				if !ok && resp.Model.Data != nil {
+					return fmt.Errorf("running Resource Graph query: expected the results to be an array but got %T", resp.Model.Data)
+				}
+				rows = append(rows, page...)
+				state.TotalRecords = resp.Model.TotalRecords
+
+				if resp.Model.SkipToken == nil || *resp.Model.SkipToken == "" {
+					break
+				}
+				input.Options.SkipToken = resp.Model.SkipToken
+			}
+
+			results, err := flattenResourceGraphQueryResults(rows)
+			if err != nil {
+				return err
+			}
+			state.Results = results
+
+			resultsJson, err := json.Marshal(rows)
+			if err != nil {
+				return fmt.Errorf("serializing the results of the Resource Graph query: %+v", err)
+			}
+			state.ResultsJson = string(resultsJson)
+
+			// the same query may be run against multiple scopes, so the ID is derived from both
+			idHash := sha256.Sum256([]byte(fmt.Sprintf("%s|%s", state.Query, strings.Join(scopes, ","))))
+			metadata.ResourceData.SetId(hex.EncodeToString(idHash[:]))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenResourceGraphQueryResults(input []interface{}) ([]ResourceGraphQueryResultModel, error) {
+	output := make([]ResourceGraphQueryResultModel, 0)
+	for i, item := range input {
+		row, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected row %d of the Resource Graph query to be an object but got %T", i, item)
+		}
+
+		raw, err := json.Marshal(row)
+		if err != nil {
+			return nil, fmt.Errorf("serializing row %d of the Resource Graph query: %+v", i, err)
+		}
+
+		result := ResourceGraphQueryResultModel{
+			Id:                resourceGraphQueryColumnAsString(row, "id"),
+			Name:              resourceGraphQueryColumnAsString(row, "name"),
+			Type:              resourceGraphQueryColumnAsString(row, "type"),
+			Location:          location.Normalize(resourceGraphQueryColumnAsString(row, "location")),
+			ResourceGroupName: resourceGraphQueryColumnAsString(row, "resourceGroup"),
+			SubscriptionId:    resourceGraphQueryColumnAsString(row, "subscriptionId"),
+			Tags:              make(map[string]string),
+			Json:              string(raw),
+		}
+
+		if tags, ok := row["tags"].(map[string]interface{}); ok {
+			for k, v := range tags {
+				if s, ok := v.(string); ok {
+					result.Tags[k] = s
+				}
+			}
+		}
+
+		output = append(output, result)
+	}
+
+	return output, nil
+}
+
+// resourceGraphQueryColumnAsString returns the value of the specified column as a string - since the columns
+// are defined by the query these may not be present (or may be projected as a different type)
+func resourceGraphQueryColumnAsString(row map[string]interface{}, column string) string {
+	v, ok := row[column].(string)
+	if !ok {
+		return ""
+	}
+
+	return v
+}
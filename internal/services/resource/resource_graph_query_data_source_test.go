@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceGraphQueryDataSource struct{}
+
+// NOTE: Resource Graph is eventually consistent, so these tests query the Subscription the tests are running
+// in (which exists in Resource Graph) rather than resources created within the test, which may not yet be indexed
+
+func TestAccDataSourceResourceGraphQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+				check.That(data.ResourceName).Key("results.0.type").HasValue("microsoft.resources/subscriptions"),
+				check.That(data.ResourceName).Key("total_records").HasValue("1"),
+				check.That(data.ResourceName).Key("results_json").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceGraphQuery_projection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.projection(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+				check.That(data.ResourceName).Key("results.0.json").Exists(),
+			),
+		},
+	})
+}
+
+func (ResourceGraphQueryDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_resource_graph_query" "test" {
+  query = "resourcecontainers | where type =~ 'microsoft.resources/subscriptions' | where subscriptionId =~ '${data.azurerm_client_config.current.subscription_id}'"
+}
+`
+}
+
+func (ResourceGraphQueryDataSource) projection() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_resource_graph_query" "test" {
+  query            = "resourcecontainers | where type =~ 'microsoft.resources/subscriptions' | where subscriptionId =~ '${data.azurerm_client_config.current.subscription_id}' | project subscriptionId, name"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`
+}
@@ -483,7 +483,7 @@ func resourceSearchServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		model.Properties.SemanticSearch = pointer.To(semanticSearchSku)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		model.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -113,7 +113,7 @@ func (r ThreatIntelligenceIndicator) Arguments() map[string]*pluginsdk.Schema {
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&workspaces.WorkspaceId{})),
 		},
 
 		"confidence": {
@@ -603,7 +603,7 @@ func (r ThreatIntelligenceIndicator) Update() sdk.ResourceFunc {
 				properties.KillChainPhases = expandThreatIntelligenceKillChainPhaseModel(model.KillChainPhases)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Labels = &model.Labels
 			}
 
@@ -51,15 +51,19 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 			},
 
 			"primary_namespace_id": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceIDOfType(&disasterrecoveryconfigs.NamespaceId{}),
 			},
 
 			"partner_namespace_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty, // nolint: staticcheck
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					azure.ValidateResourceIDOrEmpty, // nolint: staticcheck
+					validation.ResourceIDOfTypeOrEmpty(&disasterrecoveryconfigs.NamespaceId{}),
+				),
 			},
 
 			"alias_authorization_rule_id": {
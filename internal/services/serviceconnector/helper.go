@@ -39,7 +39,7 @@ func secretStoreSchema() *pluginsdk.Schema {
 				"key_vault_id": {
 					Type:         pluginsdk.TypeString,
 					Required:     true,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.ResourceIDOfType(&commonids.KeyVaultId{})),
 				},
 			},
 		},
@@ -186,7 +186,7 @@ func (f FrontendsResource) Update() sdk.ResourceFunc {
 
 			update := frontendsinterface.FrontendUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				update.Tags = tags.Expand(config.Tags)
 			}
 			if _, err := client.Update(ctx, *id, update); err != nil {
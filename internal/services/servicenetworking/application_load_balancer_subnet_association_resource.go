@@ -185,7 +185,7 @@ func (t ApplicationLoadBalancerSubnetAssociationResource) Update() sdk.ResourceF
 			// Tracked on https://github.com/Azure/azure-rest-api-specs/issues/26657
 			associationUpdate := associationsinterface.AssociationUpdate{}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				associationUpdate.Tags = tags.Expand(config.Tags)
 			}
 
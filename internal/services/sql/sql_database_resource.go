@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql" // nolint: staticcheck
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -526,9 +527,10 @@ func resourceSqlDatabaseSchema() map[string]*pluginsdk.Schema {
 		},
 
 		"source_database_id": {
-			Type:     pluginsdk.TypeString,
-			Optional: true,
-			Computed: true,
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.ResourceIDOfType(&commonids.SqlDatabaseId{}),
 		},
 
 		"restore_point_in_time": {
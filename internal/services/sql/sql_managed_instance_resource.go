@@ -175,7 +175,7 @@ func resourceArmSqlMiServer() *schema.Resource {
 			"dns_zone_partner_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.SqlManagedInstanceId{})),
 			},
 
 			// TODO: support User Assigned https://github.com/hashicorp/terraform-provider-azurerm/issues/15277
@@ -91,7 +91,7 @@ func resourceStorageAccountNetworkRulesSchema() map[string]*pluginsdk.Schema {
 			ConfigMode: pluginsdk.SchemaConfigModeAttr,
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validation.All(azure.ValidateResourceID, validation.ResourceIDOfType(&commonids.SubnetId{})),
 			},
 			Set: pluginsdk.HashString,
 		},
@@ -251,19 +251,28 @@ func (r SyncServerEndpointResource) Update() sdk.ResourceFunc {
 			}
 
 			payload := serverendpointresource.ServerEndpointUpdateParameters{
-				Properties: &serverendpointresource.ServerEndpointUpdateProperties{
-					LocalCacheMode:         pointer.To(serverendpointresource.LocalCacheMode(config.LocalCacheMode)),
-					VolumeFreeSpacePercent: pointer.To(config.VolumeFreeSpacePercent),
-				},
+				Properties: &serverendpointresource.ServerEndpointUpdateProperties{},
 			}
 
-			cloudTieringEnabled := serverendpointresource.FeatureStatusOff
-			if config.CloudTieringEnabled {
-				cloudTieringEnabled = serverendpointresource.FeatureStatusOn
+			if metadata.ResourceData.HasChange("local_cache_mode") {
+				payload.Properties.LocalCacheMode = pointer.To(serverendpointresource.LocalCacheMode(config.LocalCacheMode))
 			}
-			payload.Properties.CloudTiering = pointer.To(cloudTieringEnabled)
 
-			if config.TierFilesOlderThanDays != 0 {
+			if metadata.ResourceData.HasChange("volume_free_space_percent") {
+				payload.Properties.VolumeFreeSpacePercent = pointer.To(config.VolumeFreeSpacePercent)
+			}
+
+			if metadata.ResourceData.HasChange("cloud_tiering_enabled") {
+				cloudTieringEnabled := serverendpointresource.FeatureStatusOff
+				if config.CloudTieringEnabled {
+					cloudTieringEnabled = serverendpointresource.FeatureStatusOn
+				}
+				payload.Properties.CloudTiering = pointer.To(cloudTieringEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("tier_files_older_than_days") {
+				// when this has been removed from the configuration the date policy is reset, rather than
+				// being left as-is by omitting it from the request
 				payload.Properties.TierFilesOlderThanDays = pointer.To(config.TierFilesOlderThanDays)
 			}
 
@@ -352,7 +352,7 @@ func (r ManagedLustreFileSystemResource) Update() sdk.ResourceFunc {
 				properties.Properties.EncryptionSettings = expandManagedLustreFileSystemEncryptionKey(model.EncryptionKey)
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = pointer.To(model.Tags)
 			}
 
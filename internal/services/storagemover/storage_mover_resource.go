@@ -142,7 +142,7 @@ func (r StorageMoverResource) Update() sdk.ResourceFunc {
 				properties.Properties.Description = &model.Description
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
@@ -197,7 +197,7 @@ func (r ClusterResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if metadata.ResourceData.HasChange("streaming_capacity") || metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChange("streaming_capacity") || metadata.ResourceData.HasChanges("tags", "tags_all") {
 				props := clusters.Cluster{
 					Sku: &clusters.ClusterSku{
 						Capacity: pointer.To(state.StreamingCapacity),
@@ -235,7 +235,7 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("failed waiting for Subscription %q (Alias %q) to enter %q state: %+v", *alias.Model.Properties.SubscriptionId, id.AliasName, "Active", err)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsClient := meta.(*clients.Client).Resource.TagsClient
 		t := tags.Expand(d.Get("tags").(map[string]interface{}))
 		scope := commonids.NewScopeID(commonids.NewSubscriptionID(*alias.Model.Properties.SubscriptionId).ID())
@@ -285,7 +285,7 @@ func resourceSubscriptionUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsClient := meta.(*clients.Client).Resource.TagsClient
 		t := tags.Expand(d.Get("tags").(map[string]interface{}))
 		scope := commonids.NewScopeID(subscriptionId.ID())
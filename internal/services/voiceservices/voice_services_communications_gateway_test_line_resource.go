@@ -166,7 +166,7 @@ func (r CommunicationsGatewayTestLineResource) Update() sdk.ResourceFunc {
 				properties.Properties.Purpose = model.Purpose
 			}
 
-			if metadata.ResourceData.HasChange("tags") {
+			if metadata.ResourceData.HasChanges("tags", "tags_all") {
 				properties.Tags = &model.Tags
 			}
 
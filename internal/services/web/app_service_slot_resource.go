@@ -68,9 +68,10 @@ func resourceAppServiceSlot() *pluginsdk.Resource {
 			},
 
 			"app_service_plan_id": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceIDOfType(&commonids.AppServicePlanId{}),
 			},
 
 			"site_config": schemaAppServiceSiteConfig(),
@@ -63,8 +63,9 @@ func resourceFunctionApp() *pluginsdk.Resource {
 			"location": commonschema.Location(),
 
 			"app_service_plan_id": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ResourceIDOfType(&commonids.AppServicePlanId{}),
 			},
 
 			"app_settings": {
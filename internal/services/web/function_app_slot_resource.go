@@ -70,9 +70,10 @@ func resourceFunctionAppSlot() *pluginsdk.Resource {
 			},
 
 			"app_service_plan_id": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceIDOfType(&commonids.AppServicePlanId{}),
 			},
 
 			"version": {
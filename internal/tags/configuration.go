@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import "strings"
+
+// Configuration contains the Default Tags defined in the `default_tags` block of the Provider and the tag keys
+// and key prefixes defined in the `ignore_tags` block of the Provider, which are applied to the tags of each
+// resource when it's written and read (see ConfigureResource and ConfigureDataSource).
+//
+// This is held on the Client rather than globally, since each (aliased) Provider has its own configuration.
+type Configuration struct {
+	defaults           map[string]string
+	ignoredKeys        map[string]struct{}
+	ignoredKeyPrefixes []string
+}
+
+func NewConfiguration(defaults map[string]string, ignoredKeys []string, ignoredKeyPrefixes []string) *Configuration {
+	c := &Configuration{
+		defaults:           make(map[string]string, len(defaults)),
+		ignoredKeys:        make(map[string]struct{}, len(ignoredKeys)),
+		ignoredKeyPrefixes: make([]string, 0, len(ignoredKeyPrefixes)),
+	}
+	for k, v := range defaults {
+		c.defaults[k] = v
+	}
+
+	// tag keys are case-insensitive in Azure, so the ignored keys and prefixes are compared in lower-case
+	for _, k := range ignoredKeys {
+		if k != "" {
+			c.ignoredKeys[strings.ToLower(k)] = struct{}{}
+		}
+	}
+	for _, p := range ignoredKeyPrefixes {
+		if p != "" {
+			c.ignoredKeyPrefixes = append(c.ignoredKeyPrefixes, strings.ToLower(p))
+		}
+	}
+
+	return c
+}
+
+// Defaults returns a copy of the Tags defined in the `default_tags` block of the Provider
+func (c *Configuration) Defaults() map[string]string {
+	if c == nil {
+		return map[string]string{}
+	}
+
+	output := make(map[string]string, len(c.defaults))
+	for k, v := range c.defaults {
+		output[k] = v
+	}
+
+	return output
+}
+
+// merge returns the tags which should be sent to Azure for a resource - that is the tags configured on the
+// resource, merged with the Default Tags and any ignored tags present on the existing resource. Where a tag
+// is defined in more than one of these the configured value takes precedence, followed by the Default Tag.
+func (c *Configuration) merge(configured map[string]interface{}, existing map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	// ignored tags are managed outside of Terraform, so are preserved to avoid removing them
+	for k, v := range existing {
+		if c.IsIgnored(k) {
+			output[k] = v
+		}
+	}
+	for k, v := range c.Defaults() {
+		output[k] = v
+	}
+	for k, v := range configured {
+		output[k] = v
+	}
+
+	return output
+}
+
+// strip removes any ignored tags from the tags returned from Azure, alongside (when stripDefaults is set) any
+// tags which match a Default Tag (both key and value) - unless that key has been explicitly configured
+func (c *Configuration) strip(input map[string]interface{}, configured map[string]interface{}, stripDefaults bool) map[string]interface{} {
+	defaults := map[string]string{}
+	if stripDefaults {
+		defaults = c.Defaults()
+	}
+
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		if c.IsIgnored(k) {
+			continue
+		}
+
+		if _, ok := configured[k]; !ok {
+			if defaultValue, isDefault := defaults[k]; isDefault && defaultValue == v {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestConfigurationMerge(t *testing.T) {
+	configuration := NewConfiguration(map[string]string{
+		"cost-center": "1234",
+		"environment": "production",
+	}, []string{"ms-resource-usage"}, nil)
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Existing map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:  "Empty",
+			Input: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+			},
+		},
+		{
+			Name: "Additional Tag",
+			Input: map[string]interface{}{
+				"owner": "platform",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+				"owner":       "platform",
+			},
+		},
+		{
+			Name: "Overridden Tag",
+			Input: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "staging",
+			},
+		},
+		{
+			Name: "Existing Ignored Tag",
+			Input: map[string]interface{}{
+				"owner": "platform",
+			},
+			Existing: map[string]interface{}{
+				"cost-center":       "1234",
+				"ms-resource-usage": "azure-cloud-shell",
+				"removed":           "true",
+			},
+			Expected: map[string]interface{}{
+				"cost-center":       "1234",
+				"environment":       "production",
+				"ms-resource-usage": "azure-cloud-shell",
+				"owner":             "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := configuration.merge(v.Input, v.Existing)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestConfigurationStrip(t *testing.T) {
+	configuration := NewConfiguration(map[string]string{
+		"cost-center": "1234",
+		"environment": "production",
+	}, []string{"ms-resource-usage"}, nil)
+
+	testData := []struct {
+		Name          string
+		Input         map[string]interface{}
+		Configured    map[string]interface{}
+		StripDefaults bool
+		Expected      map[string]interface{}
+	}{
+		{
+			Name: "Only Default Tags",
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+			},
+			StripDefaults: true,
+			Expected:      map[string]interface{}{},
+		},
+		{
+			Name: "Default Tags retained",
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+			},
+			StripDefaults: false,
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+			},
+		},
+		{
+			Name: "Default Tag with a different value",
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "staging",
+			},
+			StripDefaults: true,
+			Expected: map[string]interface{}{
+				"environment": "staging",
+			},
+		},
+		{
+			Name: "Default Tag explicitly configured",
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+			},
+			Configured: map[string]interface{}{
+				"environment": "production",
+			},
+			StripDefaults: true,
+			Expected: map[string]interface{}{
+				"environment": "production",
+			},
+		},
+		{
+			Name: "Ignored Tag",
+			Input: map[string]interface{}{
+				"MS-Resource-Usage": "azure-cloud-shell",
+				"owner":             "platform",
+			},
+			StripDefaults: false,
+			Expected: map[string]interface{}{
+				"owner": "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := configuration.strip(v.Input, v.Configured, v.StripDefaults)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestConfigureResourceRead(t *testing.T) {
+	configuration := NewConfiguration(map[string]string{
+		"environment": "production",
+	}, []string{"ms-resource-usage"}, nil)
+
+	noop := func(_ context.Context, _ *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+		return nil
+	}
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": Schema(),
+		},
+		CreateContext: noop,
+		UpdateContext: noop,
+		DeleteContext: noop,
+		ReadContext: func(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			return diag.FromErr(d.Set("tags", map[string]interface{}{
+				"environment":       "production",
+				"ms-resource-usage": "azure-cloud-shell",
+				"owner":             "platform",
+			}))
+		},
+	}
+	ConfigureResource("azurerm_example", resource, func(_ interface{}) *Configuration {
+		return configuration
+	})
+
+	if _, ok := resource.Schema["tags_all"]; !ok {
+		t.Fatalf("Expected `tags_all` to be exposed")
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	d.SetId("example")
+	if diags := resource.ReadContext(context.TODO(), d, nil); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"owner": "platform",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected `tags` to be %+v but got %+v", expected, actual)
+	}
+
+	expected = map[string]interface{}{
+		"environment":       "production",
+		"ms-resource-usage": "azure-cloud-shell",
+		"owner":             "platform",
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected `tags_all` to be %+v but got %+v", expected, actual)
+	}
+}
+
+func TestConfigureResourceWarnsWhenDefaultTagsUnsupported(t *testing.T) {
+	noop := func(_ context.Context, _ *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+		return nil
+	}
+	forceNewTags := Schema()
+	forceNewTags.ForceNew = true
+
+	testData := []struct {
+		name            string
+		defaults        map[string]string
+		resource        *pluginsdk.Resource
+		expectedWarning bool
+	}{
+		{
+			name:     "tags can be updated in-place",
+			defaults: map[string]string{"environment": "production"},
+			resource: &pluginsdk.Resource{
+				Schema:        map[string]*pluginsdk.Schema{"tags": Schema()},
+				CreateContext: noop,
+				ReadContext:   noop,
+				UpdateContext: noop,
+				DeleteContext: noop,
+			},
+			expectedWarning: false,
+		},
+		{
+			name:     "tags are ForceNew",
+			defaults: map[string]string{"environment": "production"},
+			resource: &pluginsdk.Resource{
+				Schema:        map[string]*pluginsdk.Schema{"tags": forceNewTags},
+				CreateContext: noop,
+				ReadContext:   noop,
+				UpdateContext: noop,
+				DeleteContext: noop,
+			},
+			expectedWarning: true,
+		},
+		{
+			name:     "no update",
+			defaults: map[string]string{"environment": "production"},
+			resource: &pluginsdk.Resource{
+				Schema:        map[string]*pluginsdk.Schema{"tags": ForceNewSchema()},
+				CreateContext: noop,
+				ReadContext:   noop,
+				DeleteContext: noop,
+			},
+			expectedWarning: true,
+		},
+		{
+			name:     "no default tags",
+			defaults: nil,
+			resource: &pluginsdk.Resource{
+				Schema:        map[string]*pluginsdk.Schema{"tags": ForceNewSchema()},
+				CreateContext: noop,
+				ReadContext:   noop,
+				DeleteContext: noop,
+			},
+			expectedWarning: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			configuration := NewConfiguration(v.defaults, nil, nil)
+			ConfigureResource("azurerm_example", v.resource, func(_ interface{}) *Configuration {
+				return configuration
+			})
+
+			d := schema.TestResourceDataRaw(t, v.resource.Schema, map[string]interface{}{})
+			diags := v.resource.CreateContext(context.TODO(), d, nil)
+			if diags.HasError() {
+				t.Fatalf("creating: %+v", diags)
+			}
+
+			warned := false
+			for _, item := range diags {
+				if item.Severity == diag.Warning {
+					warned = true
+				}
+			}
+			if warned != v.expectedWarning {
+				t.Fatalf("expected a warning to be raised to be %t but got %+v", v.expectedWarning, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type operationFunc = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics
+
+// ConfigureResource applies the tags Configuration held on the Client to the specified Resource, so that
+// this applies to every resource exposing `tags` regardless of how the resource expands and flattens them:
+//
+//   - when the resource is read, any ignored tags are removed from `tags`, so that these don't show as a diff.
+//   - where the resource supports updating tags in-place, `tags_all` is exposed and the Default Tags are
+//     merged into `tags` (alongside any ignored tags present on the existing resource, to preserve them)
+//     for the duration of Create and Update - and are removed from `tags` again once the resource is read.
+//   - otherwise a warning is raised when the resource is created whilst Default Tags are configured, since
+//     these can't be assigned to it.
+func ConfigureResource(name string, resource *pluginsdk.Resource, configuration func(meta interface{}) *Configuration) {
+	if resource == nil || resource.Schema == nil {
+		return
+	}
+
+	if v, ok := resource.Schema["tags"]; !ok || v.Type != pluginsdk.TypeMap {
+		return
+	}
+
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return
+	}
+
+	normaliseOperations(resource)
+	withTagsAll := supportsTagsAll(resource)
+
+	if withTagsAll {
+		resource.Schema["tags_all"] = SchemaTagsAll()
+
+		customizeDiff := func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			return customizeDiffTagsAll(ctx, d, configuration(meta))
+		}
+		if resource.CustomizeDiff == nil {
+			resource.CustomizeDiff = customizeDiff
+		} else {
+			resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(resource.CustomizeDiff, customizeDiff)
+		}
+
+		resource.CreateContext = withMergedTags(resource.CreateContext, configuration)
+		resource.CreateWithoutTimeout = withMergedTags(resource.CreateWithoutTimeout, configuration)
+		resource.UpdateContext = withMergedTags(resource.UpdateContext, configuration)
+		resource.UpdateWithoutTimeout = withMergedTags(resource.UpdateWithoutTimeout, configuration)
+	} else {
+		resource.CreateContext = withDefaultTagsUnsupportedWarning(name, resource.CreateContext, configuration)
+		resource.CreateWithoutTimeout = withDefaultTagsUnsupportedWarning(name, resource.CreateWithoutTimeout, configuration)
+	}
+
+	resource.ReadContext = withStrippedTags(resource.ReadContext, configuration, withTagsAll)
+	resource.ReadWithoutTimeout = withStrippedTags(resource.ReadWithoutTimeout, configuration, withTagsAll)
+}
+
+// ConfigureDataSource applies the tags Configuration held on the Client to the specified Data Source, so that
+// any ignored tags are removed from `tags` - the Default Tags are retained, since these are assigned in Azure
+func ConfigureDataSource(dataSource *pluginsdk.Resource, configuration func(meta interface{}) *Configuration) {
+	if dataSource == nil || dataSource.Schema == nil {
+		return
+	}
+
+	if v, ok := dataSource.Schema["tags"]; !ok || v.Type != pluginsdk.TypeMap {
+		return
+	}
+
+	normaliseOperations(dataSource)
+	dataSource.ReadContext = withStrippedTags(dataSource.ReadContext, configuration, false)
+	dataSource.ReadWithoutTimeout = withStrippedTags(dataSource.ReadWithoutTimeout, configuration, false)
+}
+
+// withMergedTags sets `tags` to the tags which should be sent to Azure for the duration of the operation, so
+// that these are used by the resource however it expands them - and once complete, sets `tags_all` and `tags`
+// from the tags which are now in the state
+func withMergedTags(operation operationFunc, configuration func(meta interface{}) *Configuration) operationFunc {
+	if operation == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		c := configuration(meta)
+
+		configured, _ := d.Get("tags").(map[string]interface{})
+		old, _ := d.GetChange("tags_all")
+		existing, _ := old.(map[string]interface{})
+
+		if err := d.Set("tags", c.merge(configured, existing)); err != nil {
+			return diag.Errorf("setting `tags`: %+v", err)
+		}
+
+		diags := operation(ctx, d, meta)
+
+		// this is done regardless of whether the operation succeeded, since a partial state is otherwise persisted
+		if err := setTagsFromState(d, c, configured, true); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// withDefaultTagsUnsupportedWarning raises a warning when a resource which can't have the Default Tags assigned
+// (since it requires replacing to change its tags) is created whilst Default Tags are configured
+func withDefaultTagsUnsupportedWarning(name string, create operationFunc, configuration func(meta interface{}) *Configuration) operationFunc {
+	if create == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := create(ctx, d, meta)
+
+		if len(configuration(meta).Defaults()) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The `default_tags` were not assigned to this %s", name),
+				Detail:   fmt.Sprintf("The `default_tags` configured on the Provider are only assigned to resources which support updating their tags in-place - since changing the tags of a %s requires replacing it, these weren't assigned. Any tags which are required should be specified in the `tags` of this resource instead.", name),
+			})
+		}
+
+		return diags
+	}
+}
+
+// withStrippedTags removes any ignored tags (and where `tags_all` is exposed, any Default Tags) from the `tags`
+// set when the resource was read - Default Tags which were configured on the resource prior to it being read
+// are retained, so that these remain in `tags`
+func withStrippedTags(read operationFunc, configuration func(meta interface{}) *Configuration, withTagsAll bool) operationFunc {
+	if read == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		configured, _ := d.Get("tags").(map[string]interface{})
+
+		diags := read(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		if err := setTagsFromState(d, configuration(meta), configured, withTagsAll); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+func setTagsFromState(d *pluginsdk.ResourceData, c *Configuration, configured map[string]interface{}, withTagsAll bool) error {
+	current, _ := d.Get("tags").(map[string]interface{})
+
+	if withTagsAll {
+		if err := d.Set("tags_all", current); err != nil {
+			return fmt.Errorf("setting `tags_all`: %+v", err)
+		}
+	}
+
+	if err := d.Set("tags", c.strip(current, configured, withTagsAll)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// normaliseOperations replaces the deprecated Create, Read and Update functions on the Resource with their
+// context-aware equivalents, so that these can be wrapped consistently
+func normaliseOperations(resource *pluginsdk.Resource) {
+	toContext := func(f func(d *pluginsdk.ResourceData, meta interface{}) error) operationFunc {
+		return func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, meta))
+		}
+	}
+
+	//nolint:staticcheck
+	if create := resource.Create; create != nil {
+		resource.Create = nil //nolint:staticcheck
+		resource.CreateContext = toContext(create)
+	}
+	//nolint:staticcheck
+	if read := resource.Read; read != nil {
+		resource.Read = nil //nolint:staticcheck
+		resource.ReadContext = toContext(read)
+	}
+	//nolint:staticcheck
+	if update := resource.Update; update != nil {
+		resource.Update = nil //nolint:staticcheck
+		resource.UpdateContext = toContext(update)
+	}
+}
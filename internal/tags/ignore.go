@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import "strings"
+
+// IsIgnored returns whether the specified tag key matches either a key or a key prefix defined
+// in the `ignore_tags` block of the Provider - since tag keys are case-insensitive in Azure so is this
+func (c *Configuration) IsIgnored(key string) bool {
+	if c == nil {
+		return false
+	}
+
+	key = strings.ToLower(key)
+	if _, ok := c.ignoredKeys[key]; ok {
+		return true
+	}
+
+	for _, prefix := range c.ignoredKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"testing"
+)
+
+func TestIsIgnored(t *testing.T) {
+	configuration := NewConfiguration(nil, []string{"ms-resource-usage"}, []string{"hidden-link:"})
+
+	testData := []struct {
+		Key      string
+		Expected bool
+	}{
+		{
+			Key:      "environment",
+			Expected: false,
+		},
+		{
+			Key:      "ms-resource-usage",
+			Expected: true,
+		},
+		{
+			Key:      "MS-Resource-Usage",
+			Expected: true,
+		},
+		{
+			Key:      "ms-resource-usage-2",
+			Expected: false,
+		},
+		{
+			Key:      "hidden-link:/app-insights-resource-id",
+			Expected: true,
+		},
+		{
+			Key:      "hidden-link",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Key)
+
+		if actual := configuration.IsIgnored(v.Key); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// SchemaTagsAll returns the Schema used for `tags_all`, which contains the tags assigned to the resource
+// in Azure - that is the tags defined on the resource merged with any Default Tags configured on the
+// Provider, alongside any tags which are ignored by the Provider
+func SchemaTagsAll() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+// supportsTagsAll determines whether the specified Resource supports updating tags in-place, and as such
+// exposes `tags_all` - resources which can't don't, since a change to the Default Tags would otherwise
+// require replacing the resource.
+func supportsTagsAll(resource *pluginsdk.Resource) bool {
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != pluginsdk.TypeMap || !v.Optional || v.ForceNew {
+		return false
+	}
+
+	//nolint:staticcheck
+	return resource.Update != nil || resource.UpdateContext != nil || resource.UpdateWithoutTimeout != nil
+}
+
+func customizeDiffTagsAll(_ context.Context, d *pluginsdk.ResourceDiff, configuration *Configuration) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	configured, _ := d.Get("tags").(map[string]interface{})
+	old, _ := d.GetChange("tags_all")
+	existing, _ := old.(map[string]interface{})
+
+	all := configuration.merge(configured, existing)
+	if (len(existing) == 0 && len(all) == 0) || reflect.DeepEqual(existing, all) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", all)
+}
@@ -3,6 +3,11 @@
 
 package pluginsdk
 
+import (
+	"strconv"
+	"strings"
+)
+
 // IsExplicitlyNullInConfig determines whether the specified 'configFieldName'
 // exists in the configuration file or not.
 //
@@ -19,3 +24,54 @@ func IsExplicitlyNullInConfig(d *ResourceData, configFieldName string) bool {
 
 	return isNull
 }
+
+// IsExplicitlyConfigured determines whether the field at the specified 'key' (for example `name` or
+// `network_rules.0.default_action`) has been given a value in the configuration file.
+//
+// Since Terraform doesn't differentiate between a field which is omitted and one which is explicitly set
+// to `null`, both of these return 'false' - whereas a field which is set to its zero value (for example
+// `false`, `0` or `""`) returns 'true'. A field whose value isn't known yet is also considered configured.
+//
+// NOTE: the configuration is only available during Create and Update - and so this always returns 'false'
+// when called from a Read.
+func IsExplicitlyConfigured(d *ResourceData, key string) bool {
+	value := d.GetRawConfig()
+
+	for _, segment := range strings.Split(key, ".") {
+		if !value.IsKnown() {
+			return true
+		}
+		if value.IsNull() {
+			return false
+		}
+
+		valueType := value.Type()
+		switch {
+		case valueType.IsObjectType():
+			if !valueType.HasAttribute(segment) {
+				return false
+			}
+			value = value.GetAttr(segment)
+
+		case valueType.IsMapType():
+			item, ok := value.AsValueMap()[segment]
+			if !ok {
+				return false
+			}
+			value = item
+
+		case valueType.IsListType(), valueType.IsSetType(), valueType.IsTupleType():
+			items := value.AsValueSlice()
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(items) {
+				return false
+			}
+			value = items[index]
+
+		default:
+			return false
+		}
+	}
+
+	return !value.IsKnown() || !value.IsNull()
+}
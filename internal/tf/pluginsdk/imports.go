@@ -6,6 +6,7 @@ package pluginsdk
 import (
 	"context"
 	"log"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,7 +27,7 @@ func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.Resourc
 // ImporterValidatingResourceIdThen validates the ID provided at import time is valid
 // using the validateFunc then runs the 'thenFunc', allowing the import to be customised.
 func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
+	importer := &schema.ResourceImporter{
 		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
@@ -44,4 +45,28 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 			return thenFunc(ctx, d, meta)
 		},
 	}
+
+	importerIDValidationFuncs.Store(importer, validateFunc)
+
+	return importer
+}
+
+// importerIDValidationFuncs is a map of the Importers created by ImporterValidatingResourceIdThen to the IDValidationFunc
+// they validate the Resource ID with
+var importerIDValidationFuncs sync.Map
+
+// IDValidationFuncForImporter returns the IDValidationFunc used to validate the Resource ID by the specified Importer,
+// when it was created using ImporterValidatingResourceId or ImporterValidatingResourceIdThen - allowing the Resource ID
+// to be validated without running the Importer itself, which requires a configured Provider.
+func IDValidationFuncForImporter(importer *schema.ResourceImporter) (IDValidationFunc, bool) {
+	if importer == nil {
+		return nil, false
+	}
+
+	v, ok := importerIDValidationFuncs.Load(importer)
+	if !ok {
+		return nil, false
+	}
+
+	return v.(IDValidationFunc), true
 }
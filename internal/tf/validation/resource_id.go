@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+// ResourceIDOfType returns a SchemaValidateFunc which tests if the provided value is a Resource ID of the same
+// type as `id` - which should be a pointer to the typed Resource ID (e.g. `&commonids.SubnetId{}`) - meaning that
+// (for example) a Virtual Network ID can't be specified where a Subnet ID is expected.
+//
+// This is intended for existing arguments which don't validate the type of Resource ID - as such until 4.0 a
+// value which isn't a Resource ID of this type results in a warning rather than an error. New arguments should
+// use the `ValidateXXXID` function for the Resource ID instead.
+func ResourceIDOfType(id resourceids.ResourceId) func(interface{}, string) ([]string, []error) {
+	return resourceIDOfType(id, false)
+}
+
+// ResourceIDOfTypeOrEmpty is the same as ResourceIDOfType, but also allows the value to be empty
+func ResourceIDOfTypeOrEmpty(id resourceids.ResourceId) func(interface{}, string) ([]string, []error) {
+	return resourceIDOfType(id, true)
+}
+
+func resourceIDOfType(id resourceids.ResourceId, allowEmpty bool) func(interface{}, string) ([]string, []error) {
+	idType := reflect.TypeOf(id)
+	if idType.Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("ResourceIDOfType: expected a pointer to a Resource ID but got %T", id))
+	}
+	parser := resourceids.NewParserFromResourceIdType(id)
+
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+			return
+		}
+
+		if v == "" && allowEmpty {
+			return
+		}
+
+		err := parseResourceIDOfType(parser, idType, v)
+		if err == nil {
+			return
+		}
+
+		if !features.FourPointOhBeta() {
+			warnings = append(warnings, fmt.Sprintf("%q: %+v - this will be an error in v4.0 of the AzureRM Provider", k, err))
+			return
+		}
+
+		errors = append(errors, fmt.Errorf("%q: %+v", k, err))
+		return
+	}
+}
+
+func parseResourceIDOfType(parser resourceids.Parser, idType reflect.Type, input string) error {
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return err
+	}
+
+	// populating a new instance of the Resource ID ensures that all of the segments were specified
+	result, ok := reflect.New(idType.Elem()).Interface().(resourceids.ResourceId)
+	if !ok {
+		return fmt.Errorf("internal-error: %s isn't a Resource ID", idType)
+	}
+
+	return result.FromParseResult(*parsed)
+}
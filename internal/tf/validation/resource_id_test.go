@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+func TestResourceIDOfType(t *testing.T) {
+	cases := map[string]struct {
+		Value                  interface{}
+		ExpectValidationErrors bool
+		// until 4.0 a value which isn't a Subnet ID results in a warning rather than an error
+		ExpectWarningsPriorToFourPointOh bool
+	}{
+		"accept subnet id": {
+			Value:                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			ExpectValidationErrors: false,
+		},
+		"reject virtual network id": {
+			Value:                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			ExpectValidationErrors:           true,
+			ExpectWarningsPriorToFourPointOh: true,
+		},
+		"reject id for a different resource type": {
+			Value:                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1/securityRules/rule1",
+			ExpectValidationErrors:           true,
+			ExpectWarningsPriorToFourPointOh: true,
+		},
+		"reject empty string": {
+			Value:                            "",
+			ExpectValidationErrors:           true,
+			ExpectWarningsPriorToFourPointOh: true,
+		},
+		"reject incorrectly typed value": {
+			Value:                  1,
+			ExpectValidationErrors: true,
+		},
+	}
+
+	validateFunc := ResourceIDOfType(&commonids.SubnetId{})
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			warnings, errors := validateFunc(tc.Value, tn)
+			if !features.FourPointOhBeta() && tc.ExpectWarningsPriorToFourPointOh {
+				if len(errors) > 0 {
+					t.Errorf("%s: unexpected errors %s", tn, errors)
+				} else if len(warnings) == 0 {
+					t.Errorf("%s: expected warnings but got none", tn)
+				}
+				return
+			}
+
+			if len(errors) > 0 && !tc.ExpectValidationErrors {
+				t.Errorf("%s: unexpected errors %s", tn, errors)
+			} else if len(errors) == 0 && tc.ExpectValidationErrors {
+				t.Errorf("%s: expected errors but got none", tn)
+			}
+		})
+	}
+}
+
+func TestResourceIDOfTypeOrEmpty(t *testing.T) {
+	cases := map[string]struct {
+		Value                  interface{}
+		ExpectValidationErrors bool
+		// until 4.0 a value which isn't a Subnet ID results in a warning rather than an error
+		ExpectWarningsPriorToFourPointOh bool
+	}{
+		"accept subnet id": {
+			Value:                  "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			ExpectValidationErrors: false,
+		},
+		"accept empty string": {
+			Value:                  "",
+			ExpectValidationErrors: false,
+		},
+		"reject virtual network id": {
+			Value:                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			ExpectValidationErrors:           true,
+			ExpectWarningsPriorToFourPointOh: true,
+		},
+	}
+
+	validateFunc := ResourceIDOfTypeOrEmpty(&commonids.SubnetId{})
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			warnings, errors := validateFunc(tc.Value, tn)
+			if !features.FourPointOhBeta() && tc.ExpectWarningsPriorToFourPointOh {
+				if len(errors) > 0 {
+					t.Errorf("%s: unexpected errors %s", tn, errors)
+				} else if len(warnings) == 0 {
+					t.Errorf("%s: expected warnings but got none", tn)
+				}
+				return
+			}
+
+			if len(errors) > 0 && !tc.ExpectValidationErrors {
+				t.Errorf("%s: unexpected errors %s", tn, errors)
+			} else if len(errors) == 0 && tc.ExpectValidationErrors {
+				t.Errorf("%s: expected errors but got none", tn)
+			} else if len(warnings) > 0 && !tc.ExpectValidationErrors {
+				t.Errorf("%s: unexpected warnings %s", tn, warnings)
+			}
+		})
+	}
+}
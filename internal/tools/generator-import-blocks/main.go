@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2015-11-01/resources"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func main() {
+	subscriptionId := flag.String("subscription-id", os.Getenv("ARM_SUBSCRIPTION_ID"), "The ID of the Subscription containing the Resources to import")
+	resourceGroupName := flag.String("resource-group", "", "(Optional) The name of the Resource Group containing the Resources to import")
+	inputPath := flag.String("input", "", "(Optional) The path to a JSON file containing a list of Resources (e.g. the output of `az resource list`), used instead of listing the Resources from Azure")
+	outputPath := flag.String("output", "", "(Optional) The path to the file which the `import` blocks should be written to, defaults to stdout")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	// the Importers for the Resources within the Provider log when they're run, which isn't useful here
+	log.SetOutput(io.Discard)
+
+	if err := run(*subscriptionId, *resourceGroupName, *inputPath, *outputPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %+v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(subscriptionId, resourceGroupName, inputPath, outputPath string) error {
+	var armResources []armResource
+	var err error
+	if inputPath != "" {
+		armResources, err = loadResourcesFromFile(inputPath)
+	} else {
+		armResources, err = listResources(subscriptionId, resourceGroupName)
+	}
+	if err != nil {
+		return err
+	}
+
+	result := mapResources(armResources, candidatesFromProvider())
+
+	output := os.Stdout
+	if outputPath != "" {
+		f, err := os.Create(outputPath)
+		if err != nil {
+			return fmt.Errorf("creating %q: %+v", outputPath, err)
+		}
+		defer f.Close()
+		output = f
+	}
+
+	if _, err := output.WriteString(result.importBlocks()); err != nil {
+		return fmt.Errorf("writing the import blocks: %+v", err)
+	}
+
+	fmt.Fprint(os.Stderr, result.report())
+	return nil
+}
+
+// armResource is the subset of an Azure Resource needed to generate an `import` block
+type armResource struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func loadResourcesFromFile(path string) ([]armResource, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	var output []armResource
+	if err := json.Unmarshal(contents, &output); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", path, err)
+	}
+
+	return output, nil
+}
+
+func listResources(subscriptionId, resourceGroupName string) ([]armResource, error) {
+	if subscriptionId == "" {
+		return nil, fmt.Errorf("either `-subscription-id` or `-input` must be specified")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	environment := environments.AzurePublic()
+	credentials := auth.Credentials{
+		Environment:                           *environment,
+		ClientID:                              os.Getenv("ARM_CLIENT_ID"),
+		ClientSecret:                          os.Getenv("ARM_CLIENT_SECRET"),
+		TenantID:                              os.Getenv("ARM_TENANT_ID"),
+		EnableAuthenticatingUsingAzureCLI:     true,
+		EnableAuthenticatingUsingClientSecret: true,
+	}
+	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, credentials, environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining an authorizer: %+v", err)
+	}
+
+	client, err := resources.NewResourcesClientWithBaseURI(environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resources client: %+v", err)
+	}
+	client.Client.Authorizer = authorizer
+
+	options := resources.DefaultListOperationOptions()
+	if resourceGroupName != "" {
+		options.Filter = pointer.To(fmt.Sprintf("resourceGroup eq '%s'", resourceGroupName))
+	}
+
+	id := commonids.NewSubscriptionID(subscriptionId)
+	resp, err := client.ListComplete(ctx, id, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing the Resources within %s: %+v", id, err)
+	}
+
+	output := make([]armResource, 0)
+	for _, item := range resp.Items {
+		output = append(output, armResource{
+			Id:   pointer.From(item.Id),
+			Name: pointer.From(item.Name),
+			Type: pointer.From(item.Type),
+		})
+	}
+
+	return output, nil
+}
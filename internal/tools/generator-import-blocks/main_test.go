@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMapResources(t *testing.T) {
+	candidates := []candidate{
+		{
+			resourceType: "azurerm_example",
+			accepts: func(id string) bool {
+				return strings.Contains(id, "/providers/Example.Service/examples/")
+			},
+		},
+		{
+			resourceType: "azurerm_linux_thing",
+			accepts: func(id string) bool {
+				return strings.Contains(id, "/providers/Example.Service/things/")
+			},
+		},
+		{
+			resourceType: "azurerm_windows_thing",
+			accepts: func(id string) bool {
+				return strings.Contains(id, "/providers/Example.Service/things/")
+			},
+		},
+	}
+	input := []armResource{
+		{
+			Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Example.Service/examples/First",
+			Name: "First",
+			Type: "Example.Service/examples",
+		},
+		{
+			Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Example.Service/examples/first",
+			Name: "first",
+			Type: "Example.Service/examples",
+		},
+		{
+			Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Example.Service/things/1-thing",
+			Name: "1-thing",
+			Type: "Example.Service/things",
+		},
+		{
+			Id:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Example.Service/others/other",
+			Name: "other",
+			Type: "Example.Service/others",
+		},
+	}
+
+	result := mapResources(input, candidates)
+	if len(result.matched) != 2 {
+		t.Fatalf("expected 2 matched Resources but got %d", len(result.matched))
+	}
+	if len(result.ambiguous) != 1 {
+		t.Fatalf("expected 1 ambiguous Resource but got %d", len(result.ambiguous))
+	}
+	if len(result.unmatched) != 1 {
+		t.Fatalf("expected 1 unmatched Resource but got %d", len(result.unmatched))
+	}
+
+	blocks := result.importBlocks()
+	for _, expected := range []string{
+		"to = azurerm_example.first\n",
+		"to = azurerm_example.first_2\n",
+		"#   to = azurerm_linux_thing.resource_1_thing\n",
+		"#   to = azurerm_windows_thing.resource_1_thing\n",
+	} {
+		if !strings.Contains(blocks, expected) {
+			t.Fatalf("expected the import blocks to contain %q but got:\n%s", expected, blocks)
+		}
+	}
+
+	if report := result.report(); !strings.Contains(report, "* Example.Service/others (1)") {
+		t.Fatalf("expected the report to contain the unmatched Resource Type but got:\n%s", report)
+	}
+}
+
+func TestMapResourcesFromProvider(t *testing.T) {
+	input, err := loadResourcesFromFile("testdata/resources.json")
+	if err != nil {
+		t.Fatalf("loading Resources: %+v", err)
+	}
+
+	result := mapResources(input, candidatesFromProvider())
+
+	blocks := result.importBlocks()
+	for _, expected := range []string{
+		"to = azurerm_network_security_group.example_nsg\n",
+		"to = azurerm_storage_account.examplestorage\n",
+		"to = azurerm_virtual_network.example_network\n",
+		"#   to = azurerm_linux_virtual_machine.example_vm\n",
+		"#   to = azurerm_windows_virtual_machine.example_vm\n",
+	} {
+		if !strings.Contains(blocks, expected) {
+			t.Fatalf("expected the import blocks to contain %q but got:\n%s", expected, blocks)
+		}
+	}
+
+	if len(result.unmatched) != 1 || result.unmatched[0].Type != "Example.Unknown/widgets" {
+		t.Fatalf("expected only `Example.Unknown/widgets` to be unmatched but got %+v", result.unmatched)
+	}
+}
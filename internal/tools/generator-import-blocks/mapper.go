@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// invalidResourceId is a Resource ID which shouldn't be accepted by any Resource, used to exclude
+// Resources which don't validate the Resource ID (or which can be scoped to any Resource)
+const invalidResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Example.Invalid/resources/example"
+
+// candidate is a Terraform Resource which an Azure Resource can be imported into
+type candidate struct {
+	resourceType string
+	accepts      func(id string) bool
+}
+
+// candidatesFromProvider returns the (non-deprecated) Resources registered in the Provider, using their
+// Resource ID parsers to determine which Azure Resources can be imported into them
+func candidatesFromProvider() []candidate {
+	output := make([]candidate, 0)
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, resource := range service.Resources() {
+			if _, ok := resource.(sdk.ResourceWithDeprecationReplacedBy); ok {
+				continue
+			}
+			if _, ok := resource.(sdk.ResourceWithDeprecationAndNoReplacement); ok {
+				continue
+			}
+
+			validateFunc := resource.IDValidationFunc()
+			v := candidate{
+				resourceType: resource.ResourceType(),
+				accepts: func(id string) bool {
+					_, errs := validateFunc(id, "id")
+					return len(errs) == 0
+				},
+			}
+
+			// some Resources (e.g. those scoped to any Resource) accept any Resource ID
+			if v.accepts(invalidResourceId) {
+				continue
+			}
+
+			output = append(output, v)
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for resourceType, resource := range service.SupportedResources() {
+			if resource.DeprecationMessage != "" {
+				continue
+			}
+
+			// only Importers which validate the Resource ID prior to running any custom logic can be used here
+			validateFunc, ok := pluginsdk.IDValidationFuncForImporter(resource.Importer)
+			if !ok {
+				continue
+			}
+
+			v := candidate{
+				resourceType: resourceType,
+				accepts: func(id string) bool {
+					return validateFunc(id) == nil
+				},
+			}
+
+			// some Importers accept any value, so can't be used to determine the Resource Type
+			if v.accepts(invalidResourceId) {
+				continue
+			}
+
+			output = append(output, v)
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].resourceType < output[j].resourceType
+	})
+
+	return output
+}
+
+// mappedResource is an Azure Resource and the Terraform Resource(s) which it can be imported into
+type mappedResource struct {
+	armResource
+
+	// label is the name of this Resource within the Terraform Configuration
+	label string
+
+	resourceTypes []string
+}
+
+type mappingResult struct {
+	matched   []mappedResource
+	ambiguous []mappedResource
+	unmatched []armResource
+}
+
+// mapResources determines which of the candidate Terraform Resources each Azure Resource can be imported into
+func mapResources(input []armResource, candidates []candidate) mappingResult {
+	sort.Slice(input, func(i, j int) bool {
+		return strings.ToLower(input[i].Id) < strings.ToLower(input[j].Id)
+	})
+
+	result := mappingResult{}
+	labels := make(map[string]int)
+	for _, item := range input {
+		resourceTypes := make([]string, 0)
+		for _, v := range candidates {
+			if v.accepts(item.Id) {
+				resourceTypes = append(resourceTypes, v.resourceType)
+			}
+		}
+
+		if len(resourceTypes) == 0 {
+			result.unmatched = append(result.unmatched, item)
+			continue
+		}
+
+		label := labelForResource(item.Name)
+		labels[label]++
+		if count := labels[label]; count > 1 {
+			label = fmt.Sprintf("%s_%d", label, count)
+		}
+
+		mapped := mappedResource{
+			armResource:   item,
+			label:         label,
+			resourceTypes: resourceTypes,
+		}
+		if len(resourceTypes) == 1 {
+			result.matched = append(result.matched, mapped)
+		} else {
+			result.ambiguous = append(result.ambiguous, mapped)
+		}
+	}
+
+	return result
+}
+
+var invalidLabelCharacters = regexp.MustCompile("[^a-z0-9_]+")
+
+// labelForResource returns a valid Terraform identifier for the name of an Azure Resource
+func labelForResource(name string) string {
+	label := invalidLabelCharacters.ReplaceAllString(strings.ToLower(name), "_")
+	label = strings.Trim(label, "_")
+	if label == "" {
+		return "resource"
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = fmt.Sprintf("resource_%s", label)
+	}
+	return label
+}
+
+// importBlocks returns the `import` blocks for the mapped Resources - where an Azure Resource can be imported
+// into multiple Terraform Resources these are commented out, since the correct Resource can't be determined.
+func (r mappingResult) importBlocks() string {
+	blocks := make([]string, 0)
+	for _, item := range r.matched {
+		blocks = append(blocks, fmt.Sprintf(`import {
+  to = %s.%s
+  id = %q
+}
+`, item.resourceTypes[0], item.label, item.Id))
+	}
+
+	for _, item := range r.ambiguous {
+		lines := []string{
+			fmt.Sprintf("# %q can be imported as any of the following Resources, uncomment the appropriate one:", item.Id),
+		}
+		for _, resourceType := range item.resourceTypes {
+			lines = append(lines, fmt.Sprintf(`#
+# import {
+#   to = %s.%s
+#   id = %q
+# }`, resourceType, item.label, item.Id))
+		}
+		blocks = append(blocks, strings.Join(lines, "\n")+"\n")
+	}
+
+	return strings.Join(blocks, "\n")
+}
+
+// report returns a summary of the mapping, including the Azure Resource Types which have no matching Resource
+func (r mappingResult) report() string {
+	output := []string{
+		fmt.Sprintf("Generated %d import block(s), and %d commented-out import block(s) for Resources matching multiple Resource Types.", len(r.matched), len(r.ambiguous)),
+	}
+
+	if len(r.unmatched) > 0 {
+		unmatchedTypes := make(map[string]int)
+		for _, item := range r.unmatched {
+			unmatchedTypes[item.Type]++
+		}
+
+		names := make([]string, 0)
+		for k := range unmatchedTypes {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+
+		output = append(output, fmt.Sprintf("%d Resource(s) couldn't be matched to a Resource Type:", len(r.unmatched)))
+		for _, name := range names {
+			output = append(output, fmt.Sprintf("  * %s (%d)", name, unmatchedTypes[name]))
+		}
+	}
+
+	return strings.Join(output, "\n") + "\n"
+}
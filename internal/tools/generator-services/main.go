@@ -6,6 +6,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"go/format"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk" // nolint: typecheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // Packages in this list are deprecated and cannot be run due to breaking API changes
@@ -41,6 +43,7 @@ func main() {
 	generators := []generator{
 		githubLabelsGenerator{},
 		githubIssueLabelsGenerator{},
+		resourceProvidersGenerator{rootDirectory: *filePath},
 		teamCityServicesListGenerator{},
 		websiteCategoriesGenerator{},
 	}
@@ -219,6 +222,121 @@ var services = mapOf(
 	return writeToFile(outputFileName, formatted)
 }
 
+// resourceProvidersGenerator generates the Resource Provider required by each Resource, which is determined from the
+// Resource ID used to import the Resource in its documentation - providing this is accepted by the Resource ID parser
+// for the Resource, so that examples which are out of date (or use placeholders) are excluded.
+type resourceProvidersGenerator struct {
+	rootDirectory string
+}
+
+func (resourceProvidersGenerator) outputPath(rootDirectory string) string {
+	return fmt.Sprintf("%s/internal/resourceproviders/resource_types_gen.go", rootDirectory)
+}
+
+const resourceProvidersTemplate = `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+// resourceProvidersForResourceTypes is a map of the Terraform Resource Type to the Resource Provider it requires,
+// determined from the Resource ID which the Resource is imported using.
+var resourceProvidersForResourceTypes = map[string]string{
+%s
+}
+`
+
+func (g resourceProvidersGenerator) run(outputFileName string, _ map[string]struct{}) error {
+	resourceIdParsers := make(map[string]func(id string) bool)
+	for _, service := range provider.SupportedTypedServices() {
+		for _, resource := range service.Resources() {
+			validateFunc := resource.IDValidationFunc()
+			resourceIdParsers[resource.ResourceType()] = func(id string) bool {
+				_, errs := validateFunc(id, "id")
+				return len(errs) == 0
+			}
+		}
+	}
+	for _, service := range provider.SupportedUntypedServices() {
+		for resourceType, resource := range service.SupportedResources() {
+			validateFunc, ok := pluginsdk.IDValidationFuncForImporter(resource.Importer)
+			if !ok {
+				// the Resource ID can't be validated without running the Importer
+				continue
+			}
+
+			resourceIdParsers[resourceType] = func(id string) bool {
+				return validateFunc(id) == nil
+			}
+		}
+	}
+
+	resourceTypes := make([]string, 0)
+	for resourceType := range resourceIdParsers {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	lines := make([]string, 0)
+	for _, resourceType := range resourceTypes {
+		id, err := g.importResourceIdForResourceType(resourceType)
+		if err != nil {
+			return err
+		}
+		if id == "" || !resourceIdParsers[resourceType](id) {
+			continue
+		}
+
+		if resourceProvider := resourceProviderFromResourceId(id); resourceProvider != "" {
+			lines = append(lines, fmt.Sprintf("\t%q: %q,", resourceType, resourceProvider))
+		}
+	}
+
+	contents := fmt.Sprintf(resourceProvidersTemplate, strings.Join(lines, "\n"))
+	formatted, err := format.Source([]byte(contents))
+	if err != nil {
+		return fmt.Errorf("formatting %q: %+v", outputFileName, err)
+	}
+
+	return writeToFile(outputFileName, string(formatted))
+}
+
+var importResourceIdRegex = regexp.MustCompile(`(?m)^terraform import (azurerm_[a-z0-9_]+)\.\S+ (\S+)\s*$`)
+
+// importResourceIdForResourceType returns the Resource ID used to import the specified Resource Type in its documentation
+func (g resourceProvidersGenerator) importResourceIdForResourceType(resourceType string) (string, error) {
+	fileName := filepath.Join(g.rootDirectory, "website", "docs", "r", fmt.Sprintf("%s.html.markdown", strings.TrimPrefix(resourceType, azurerm)))
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("reading %q: %+v", fileName, err)
+	}
+
+	for _, match := range importResourceIdRegex.FindAllStringSubmatch(string(contents), -1) {
+		if match[1] == resourceType {
+			return strings.Trim(match[2], `"'`), nil
+		}
+	}
+
+	return "", nil
+}
+
+// resourceProviderFromResourceId returns the Resource Provider for the specified Resource ID - which is the last
+// `providers` segment, since Resources can be nested beneath a Resource from another Resource Provider
+func resourceProviderFromResourceId(id string) string {
+	segments := strings.Split(id, "/")
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
+
 type websiteCategoriesGenerator struct{}
 
 func (websiteCategoriesGenerator) outputPath(rootDirectory string) string {
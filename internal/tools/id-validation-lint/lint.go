@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// unrelatedResourceId is a Resource ID which shouldn't be accepted by a typed Resource ID validator, used to
+// determine whether the validator for a property accepts a Resource ID of any type
+const unrelatedResourceId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Example.Unrelated/resources/example"
+
+const (
+	reasonNoValidation    = "has no validation"
+	reasonAcceptsAnyValue = "accepts a Resource ID of any type"
+)
+
+// nonResourceIdSuffixes are the suffixes of properties which contain an identifier other than a Resource ID (such as the
+// UUID of a Tenant or Service Principal), which therefore aren't checked
+var nonResourceIdSuffixes = []string{
+	"client_id",
+	"object_id",
+	"principal_id",
+	"tenant_id",
+	"tenant_ids",
+}
+
+// nonResourceIdNames are the names of properties which contain an identifier other than a Resource ID, where the suffix
+// is also used by properties which do contain a Resource ID (for example `app_id` and `function_app_id`)
+var nonResourceIdNames = map[string]struct{}{
+	"agent_unique_host_id":                  {},
+	"alternate_id":                          {},
+	"alternative_media_id":                  {},
+	"app_id":                                {},
+	"application_id":                        {},
+	"billing_account_id":                    {},
+	"build_pack_ids":                        {},
+	"bundle_id":                             {},
+	"client_app_id":                         {},
+	"client_application_id":                 {},
+	"collection_id":                         {},
+	"command_id":                            {},
+	"content_id":                            {},
+	"correlation_id":                        {},
+	"custom_speech_model_id":                {},
+	"custom_voice_deployment_id":            {},
+	"developer_app_insights_application_id": {},
+	"document_id":                           {},
+	"enterprise_app_id":                     {},
+	"enterprise_application_id":             {},
+	"exclude_instance_ids":                  {},
+	"existing_cluster_id":                   {},
+	"facebook_application_id":               {},
+	"icon_id":                               {},
+	"include_instance_ids":                  {},
+	"instance_pool_id":                      {},
+	"last_commit_id":                        {},
+	"message_id":                            {},
+	"microsoft_app_id":                      {},
+	"node_agent_sku_id":                     {},
+	"org_id":                                {},
+	"organization_id":                       {},
+	"policy_definition_reference_id":        {},
+	"policy_definition_reference_ids":       {},
+	"reference_id":                          {},
+	"reply_to_session_id":                   {},
+	"schema_id":                             {},
+	"server_app_id":                         {},
+	"session_id":                            {},
+	"skill_id":                              {},
+	"sms_channel_account_security_id":       {},
+	"team_id":                               {},
+	"timezone_id":                           {},
+}
+
+func isResourceIdProperty(name string) bool {
+	if !strings.HasSuffix(name, "_id") && !strings.HasSuffix(name, "_ids") {
+		return false
+	}
+
+	if _, ok := nonResourceIdNames[name]; ok {
+		return false
+	}
+	for _, suffix := range nonResourceIdSuffixes {
+		if name == suffix || strings.HasSuffix(name, "_"+suffix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type finding struct {
+	resourceType string
+	isDataSource bool
+	propertyPath string
+	reason       string
+}
+
+// address returns the address used to identify this property within the exceptions file
+func (f finding) address() string {
+	if f.isDataSource {
+		return fmt.Sprintf("data.%s.%s", f.resourceType, f.propertyPath)
+	}
+
+	return fmt.Sprintf("%s.%s", f.resourceType, f.propertyPath)
+}
+
+func (f finding) String() string {
+	return fmt.Sprintf("%s: %s", f.address(), f.reason)
+}
+
+// lintProvider returns the `_id`/`_ids` arguments within the Resources and Data Sources for this Provider which
+// don't use a typed Resource ID validator
+func lintProvider(p *schema.Provider) []finding {
+	output := make([]finding, 0)
+	for resourceType, resource := range p.ResourcesMap {
+		for _, v := range lintSchema(resource.Schema, "") {
+			v.resourceType = resourceType
+			output = append(output, v)
+		}
+	}
+	for dataSourceType, dataSource := range p.DataSourcesMap {
+		for _, v := range lintSchema(dataSource.Schema, "") {
+			v.resourceType = dataSourceType
+			v.isDataSource = true
+			output = append(output, v)
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].address() < output[j].address()
+	})
+
+	return output
+}
+
+func lintSchema(input map[string]*schema.Schema, parentPath string) []finding {
+	output := make([]finding, 0)
+	for name, item := range input {
+		path := name
+		if parentPath != "" {
+			path = fmt.Sprintf("%s.%s", parentPath, name)
+		}
+
+		if !item.Required && !item.Optional {
+			// Computed-only attributes aren't specified by users, so don't need validating
+			continue
+		}
+
+		if block, ok := item.Elem.(*schema.Resource); ok {
+			output = append(output, lintSchema(block.Schema, path)...)
+			continue
+		}
+
+		if !isResourceIdProperty(name) {
+			continue
+		}
+
+		// for Lists/Sets of IDs the validation is defined on the nested Schema
+		toCheck := item
+		if nested, ok := item.Elem.(*schema.Schema); ok && (item.Type == schema.TypeList || item.Type == schema.TypeSet) {
+			toCheck = nested
+		}
+		if toCheck.Type != schema.TypeString {
+			continue
+		}
+
+		if reason := checkValidation(toCheck); reason != nil {
+			output = append(output, finding{
+				propertyPath: path,
+				reason:       *reason,
+			})
+		}
+	}
+
+	return output
+}
+
+// checkValidation returns the reason the validation for this property isn't a typed Resource ID validator, if any
+func checkValidation(input *schema.Schema) (reason *string) {
+	if input.ValidateFunc == nil && input.ValidateDiagFunc == nil {
+		v := reasonNoValidation
+		return &v
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			// the validator can't handle this value, so it's not a validator which accepts any value
+			reason = nil
+		}
+	}()
+
+	// a validator which returns a warning is also typed, since `validation.ResourceIDOfType` returns a warning
+	// rather than an error until 4.0
+	accepted := true
+	if input.ValidateFunc != nil {
+		warnings, errs := input.ValidateFunc(unrelatedResourceId, "id")
+		accepted = len(errs) == 0 && len(warnings) == 0
+	}
+	if accepted && input.ValidateDiagFunc != nil {
+		diags := input.ValidateDiagFunc(unrelatedResourceId, nil)
+		accepted = len(diags) == 0
+	}
+
+	if accepted {
+		v := reasonAcceptsAnyValue
+		return &v
+	}
+
+	return nil
+}
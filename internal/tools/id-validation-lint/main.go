@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+func main() {
+	exceptionsPath := flag.String("exceptions", "internal/tools/id-validation-lint/exceptions.txt", "The path to the file containing the properties which are excluded from this check")
+	writeExceptions := flag.Bool("write-exceptions", false, "Write all of the current findings to the exceptions file, rather than failing")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	// the Provider logs when it's being configured, which isn't useful here
+	log.SetOutput(io.Discard)
+
+	if err := run(*exceptionsPath, *writeExceptions); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %+v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(exceptionsPath string, writeExceptions bool) error {
+	findings := lintProvider(provider.AzureProvider())
+
+	if writeExceptions {
+		lines := []string{
+			"# The existing properties which can't use a typed Resource ID validator, generated using `-write-exceptions` - for example since",
+			"# these accept a Resource ID of any type, more than one type of Resource ID or a Data Plane URI. New properties shouldn't be added to this file.",
+		}
+		for _, v := range findings {
+			lines = append(lines, v.address())
+		}
+		if err := os.WriteFile(exceptionsPath, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+			return fmt.Errorf("writing %q: %+v", exceptionsPath, err)
+		}
+
+		fmt.Printf("Wrote %d exception(s) to %q\n", len(findings), exceptionsPath)
+		return nil
+	}
+
+	exceptions, err := loadExceptions(exceptionsPath)
+	if err != nil {
+		return err
+	}
+
+	violations := make([]finding, 0)
+	for _, v := range findings {
+		if _, ok := exceptions[v.address()]; ok {
+			delete(exceptions, v.address())
+			continue
+		}
+		violations = append(violations, v)
+	}
+
+	for address := range exceptions {
+		fmt.Printf("Note: %q is listed in the exceptions file but no longer needs to be - this can be removed\n", address)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	for _, v := range violations {
+		fmt.Println(v.String())
+	}
+
+	return fmt.Errorf("%d `_id` propert(ies) don't use a typed Resource ID validator (e.g. `commonids.ValidateSubnetID`)", len(violations))
+}
+
+func loadExceptions(path string) (map[string]struct{}, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	output := make(map[string]struct{})
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		output[line] = struct{}{}
+	}
+
+	return output, nil
+}
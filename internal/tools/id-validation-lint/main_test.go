@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func TestLintProvider(t *testing.T) {
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"azurerm_example": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"subnet_id": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: commonids.ValidateSubnetID,
+					},
+					"virtual_network_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"network_security_group_ids": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"network_interface_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.ResourceIDOfType(&commonids.NetworkInterfaceId{}),
+					},
+					"policy_definition_reference_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"tenant_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+					"client_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"app_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"function_app_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"block": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"key_vault_id": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: commonids.ValidateKeyVaultID,
+								},
+								"public_ip_address_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"principal_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"azurerm_example": {
+				Schema: map[string]*schema.Schema{
+					"resource_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		"azurerm_example.block.public_ip_address_id: has no validation",
+		"azurerm_example.function_app_id: has no validation",
+		"azurerm_example.network_security_group_ids: has no validation",
+		"azurerm_example.virtual_network_id: accepts a Resource ID of any type",
+		"data.azurerm_example.resource_id: has no validation",
+	}
+
+	actual := lintProvider(p)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d findings but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i, v := range expected {
+		if actual[i].String() != v {
+			t.Fatalf("expected finding %d to be %q but got %q", i, v, actual[i].String())
+		}
+	}
+}
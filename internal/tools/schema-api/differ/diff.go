@@ -5,6 +5,7 @@ package differ
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
@@ -15,104 +16,161 @@ type Differ struct {
 	current *providerjson.ProviderWrapper
 }
 
-func (d *Differ) Diff(fileName string, providerName string) []string {
+// Violation is a breaking change detected between the base (released) schema and the current schema
+type Violation struct {
+	// RuleId is the ID of the Breaking Change Rule which was violated
+	RuleId string `json:"ruleId"`
+
+	// ResourceType is the name of the Resource or Data Source containing the breaking change
+	ResourceType string `json:"resourceType"`
+
+	// IsDataSource specifies whether ResourceType is a Data Source, rather than a Resource
+	IsDataSource bool `json:"isDataSource"`
+
+	// PropertyPath is the path to the property containing the breaking change (e.g. `block.nested_property`),
+	// which is empty when the breaking change applies to the Resource/Data Source as a whole
+	PropertyPath string `json:"propertyPath,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	if v.PropertyPath != "" {
+		return fmt.Sprintf("%s (%s): %s", v.ResourceType, v.PropertyPath, v.Message)
+	}
+
+	return fmt.Sprintf("%s: %s", v.ResourceType, v.Message)
+}
+
+func (d *Differ) Diff(fileName string, providerName string) ([]Violation, error) {
 	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if err := d.loadFromFile(fileName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if d.base.ProviderName != d.current.ProviderName {
-		return []string{fmt.Sprintf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)}
+		return nil, fmt.Errorf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)
 	}
 
-	violations := make([]string, 0)
+	violations := make([]Violation, 0)
+	violations = append(violations, compareResources(d.base.ProviderSchema.ResourcesMap, d.current.ProviderSchema.ResourcesMap, false)...)
+	violations = append(violations, compareResources(d.base.ProviderSchema.DataSourcesMap, d.current.ProviderSchema.DataSourcesMap, true)...)
 
-	for resource, rs := range d.current.ProviderSchema.ResourcesMap {
-		_, ok := d.base.ProviderSchema.ResourcesMap[resource]
-		if !ok {
-			// New resource, no breaking changes to worry about
-			continue
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].ResourceType != violations[j].ResourceType {
+			return violations[i].ResourceType < violations[j].ResourceType
 		}
-		for propertyName, propertySchema := range rs.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.ResourcesMap[resource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeResource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+		if violations[i].PropertyPath != violations[j].PropertyPath {
+			return violations[i].PropertyPath < violations[j].PropertyPath
 		}
+		return violations[i].RuleId < violations[j].RuleId
+	})
+
+	return violations, nil
+}
+
+func compareResources(base map[string]providerjson.ResourceJSON, current map[string]providerjson.ResourceJSON, isDataSource bool) []Violation {
+	resourceRules := schema_rules.ResourceBreakingChangeRules
+	propertyRules := schema_rules.BreakingChangeRules
+	if isDataSource {
+		resourceRules = schema_rules.ResourceBreakingChangeRulesDataSource
+		propertyRules = schema_rules.BreakingChangeRulesDataSource
 	}
 
-	for dataSource, ds := range d.current.ProviderSchema.DataSourcesMap {
-		_, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
-		if !ok {
-			// New data source, no breaking changes to worry about
-			continue
+	violations := make([]Violation, 0)
+
+	for resourceType, baseResource := range base {
+		var currentResource *providerjson.ResourceJSON
+		if v, ok := current[resourceType]; ok {
+			currentResource = &v
 		}
-		for propertyName, propertySchema := range ds.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.DataSourcesMap[dataSource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeDataSource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
+
+		for _, rule := range resourceRules {
+			if err := rule.Check(baseResource, currentResource, resourceType); err != nil {
+				violations = append(violations, Violation{
+					RuleId:       rule.ID(),
+					ResourceType: resourceType,
+					IsDataSource: isDataSource,
+					Message:      *err,
+				})
 			}
 		}
+
+		if currentResource == nil {
+			// the removal of the Resource is reported above, so there's no need to report each property
+			continue
+		}
+
+		// new resources have no breaking changes to worry about, and are therefore not compared
+		for _, v := range compareNodes(baseResource.Schema, currentResource.Schema, "", propertyRules) {
+			v.ResourceType = resourceType
+			v.IsDataSource = isDataSource
+			violations = append(violations, v)
+		}
 	}
 
 	return violations
 }
 
-func compareNodeResource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeResource(newBase, newCurrent[k], k)...)
-		}
+// compareNodes compares the properties which exist in either the base or current schema - where a property only
+// exists in one of these, the empty SchemaJSON is used in its place for the other
+func compareNodes(base map[string]providerjson.SchemaJSON, current map[string]providerjson.SchemaJSON, parentPath string, rules []schema_rules.BreakingChangeRule) (violations []Violation) {
+	propertyNames := make(map[string]struct{})
+	for k := range base {
+		propertyNames[k] = struct{}{}
 	}
-
-	for _, v := range schema_rules.BreakingChangeRules {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
-		}
+	for k := range current {
+		propertyNames[k] = struct{}{}
 	}
 
-	return
-}
+	for propertyName := range propertyNames {
+		baseItem := base[propertyName]
+		currentItem := current[propertyName]
 
-func compareNodeDataSource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeDataSource(newBase, newCurrent[k], k)...)
+		path := propertyName
+		if parentPath != "" {
+			path = fmt.Sprintf("%s.%s", parentPath, propertyName)
 		}
-	}
 
-	for _, v := range schema_rules.BreakingChangeRulesDataSource {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+		if baseBlock := blockSchema(baseItem); baseBlock != nil {
+			// the removal of a block is reported as a single violation, rather than one per nested property
+			if currentItem.Type != "" {
+				violations = append(violations, compareNodes(baseBlock, blockSchema(currentItem), path, rules)...)
+			}
+		}
+
+		for _, rule := range rules {
+			if err := rule.Check(baseItem, currentItem, propertyName); err != nil {
+				violations = append(violations, Violation{
+					RuleId:       rule.ID(),
+					PropertyPath: path,
+					Message:      *err,
+				})
+			}
 		}
 	}
 
-	return
+	return violations
 }
 
-func nodeIsBlock(input providerjson.SchemaJSON) bool {
-	if input.Type == providerjson.SchemaTypeList || input.Type == providerjson.SchemaTypeSet {
-		if _, ok := input.Elem.(providerjson.ResourceJSON); ok {
-			return true
+// blockSchema returns the Schema for the nested properties when the specified property is a block - the Elem is
+// a ResourceJSON when loaded from a file, and a *ResourceJSON when loaded from the Provider
+func blockSchema(input providerjson.SchemaJSON) map[string]providerjson.SchemaJSON {
+	if input.Type != providerjson.SchemaTypeList && input.Type != providerjson.SchemaTypeSet {
+		return nil
+	}
+
+	switch v := input.Elem.(type) {
+	case providerjson.ResourceJSON:
+		return v.Schema
+	case *providerjson.ResourceJSON:
+		if v != nil {
+			return v.Schema
 		}
 	}
 
-	return false
+	return nil
 }
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+func TestCompareResources(t *testing.T) {
+	block := func(schema map[string]providerjson.SchemaJSON) providerjson.SchemaJSON {
+		return providerjson.SchemaJSON{
+			Type:     providerjson.SchemaTypeList,
+			Optional: true,
+			Elem: providerjson.ResourceJSON{
+				Schema: schema,
+			},
+		}
+	}
+	optionalString := providerjson.SchemaJSON{
+		Type:     providerjson.SchemaTypeString,
+		Optional: true,
+	}
+
+	testCases := []struct {
+		name         string
+		base         map[string]providerjson.ResourceJSON
+		current      map[string]providerjson.ResourceJSON
+		isDataSource bool
+		expected     []string
+	}{
+		{
+			name: "unchanged",
+			base: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString}},
+			},
+			current: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString}},
+			},
+			expected: []string{},
+		},
+		{
+			name: "new resource",
+			base: map[string]providerjson.ResourceJSON{},
+			current: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString}},
+			},
+			expected: []string{},
+		},
+		{
+			name: "resource removed",
+			base: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString}},
+			},
+			current: map[string]providerjson.ResourceJSON{},
+			expected: []string{
+				"resourceRemoved azurerm_example",
+			},
+		},
+		{
+			name: "data source removed",
+			base: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString}},
+			},
+			current:      map[string]providerjson.ResourceJSON{},
+			isDataSource: true,
+			expected: []string{
+				"resourceRemoved data.azurerm_example",
+			},
+		},
+		{
+			name: "property removed",
+			base: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString, "sku": optionalString}},
+			},
+			current: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString}},
+			},
+			expected: []string{
+				"propertyRemoved azurerm_example (sku)",
+			},
+		},
+		{
+			name: "nested property removed",
+			base: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{
+					"network": block(map[string]providerjson.SchemaJSON{"subnet_id": optionalString, "zone": optionalString}),
+				}},
+			},
+			current: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{
+					"network": block(map[string]providerjson.SchemaJSON{"subnet_id": optionalString}),
+				}},
+			},
+			expected: []string{
+				"propertyRemoved azurerm_example (network.zone)",
+			},
+		},
+		{
+			name: "nested property changed when loaded from the provider",
+			base: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{
+					"network": block(map[string]providerjson.SchemaJSON{"subnet_id": optionalString}),
+				}},
+			},
+			current: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{
+					"network": {
+						Type:     providerjson.SchemaTypeList,
+						Optional: true,
+						Elem: &providerjson.ResourceJSON{
+							Schema: map[string]providerjson.SchemaJSON{
+								"subnet_id": {
+									Type:     providerjson.SchemaTypeString,
+									Optional: true,
+									ForceNew: true,
+								},
+							},
+						},
+					},
+				}},
+			},
+			expected: []string{
+				"forceNewAdded azurerm_example (network.subnet_id)",
+			},
+		},
+		{
+			name: "block removed",
+			base: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{
+					"name":    optionalString,
+					"network": block(map[string]providerjson.SchemaJSON{"subnet_id": optionalString, "zone": optionalString}),
+				}},
+			},
+			current: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString}},
+			},
+			expected: []string{
+				"propertyRemoved azurerm_example (network)",
+			},
+		},
+		{
+			name: "data source property changed",
+			base: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{"name": optionalString, "sku": optionalString}},
+			},
+			current: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {Schema: map[string]providerjson.SchemaJSON{
+					// only the removal of `sku` is a breaking change for a Data Source, since this isn't configurable
+					"name": {
+						Type:     providerjson.SchemaTypeString,
+						Required: true,
+					},
+				}},
+			},
+			isDataSource: true,
+			expected: []string{
+				"propertyRemoved data.azurerm_example (sku)",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := make([]string, 0)
+			for _, v := range compareResources(tc.base, tc.current, tc.isDataSource) {
+				name := v.ResourceType
+				if v.IsDataSource {
+					name = fmt.Sprintf("data.%s", name)
+				}
+				if v.PropertyPath != "" {
+					name = fmt.Sprintf("%s (%s)", name, v.PropertyPath)
+				}
+				actual = append(actual, fmt.Sprintf("%s %s", v.RuleId, name))
+			}
+			sort.Strings(actual)
+
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("expected the violations %q but got %q", tc.expected, actual)
+			}
+		})
+	}
+}
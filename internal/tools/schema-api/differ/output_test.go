@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+var testViolations = []Violation{
+	{
+		RuleId:       "resourceRemoved",
+		ResourceType: "azurerm_example",
+		IsDataSource: true,
+		Message:      `"azurerm_example" has been removed`,
+	},
+	{
+		RuleId:       "forceNewAdded",
+		ResourceType: "azurerm_example",
+		PropertyPath: "network.subnet_id",
+		Message:      `Cannot change property "subnet_id" to ForceNew, since this would recreate existing resources`,
+	},
+	{
+		RuleId:       "propertyRemoved",
+		ResourceType: "azurerm_other",
+		PropertyPath: "sku",
+		Message:      `property "sku" has been removed`,
+	},
+	{
+		RuleId:       "resourceRemoved",
+		ResourceType: "azurerm_other_example",
+		Message:      `"azurerm_other_example" has been removed`,
+	},
+}
+
+func TestWriteViolationsText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteViolations(&buf, testViolations, OutputFormatText); err != nil {
+		t.Fatalf("writing violations: %+v", err)
+	}
+
+	expected := `azurerm_example: "azurerm_example" has been removed
+azurerm_example (network.subnet_id): Cannot change property "subnet_id" to ForceNew, since this would recreate existing resources
+azurerm_other (sku): property "sku" has been removed
+azurerm_other_example: "azurerm_other_example" has been removed
+`
+	if actual := buf.String(); actual != expected {
+		t.Fatalf("expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+}
+
+func TestWriteViolationsJSON(t *testing.T) {
+	testCases := []struct {
+		name       string
+		violations []Violation
+		expected   []map[string]interface{}
+	}{
+		{
+			name:       "none",
+			violations: []Violation{},
+			expected:   []map[string]interface{}{},
+		},
+		{
+			name:       "resource and property",
+			violations: testViolations[1:3],
+			expected: []map[string]interface{}{
+				{
+					"ruleId":       "forceNewAdded",
+					"resourceType": "azurerm_example",
+					"isDataSource": false,
+					"propertyPath": "network.subnet_id",
+					"message":      `Cannot change property "subnet_id" to ForceNew, since this would recreate existing resources`,
+				},
+				{
+					"ruleId":       "propertyRemoved",
+					"resourceType": "azurerm_other",
+					"isDataSource": false,
+					"propertyPath": "sku",
+					"message":      `property "sku" has been removed`,
+				},
+			},
+		},
+		{
+			name:       "data source",
+			violations: testViolations[0:1],
+			expected: []map[string]interface{}{
+				{
+					// the propertyPath is omitted when the Violation applies to the Data Source as a whole
+					"ruleId":       "resourceRemoved",
+					"resourceType": "azurerm_example",
+					"isDataSource": true,
+					"message":      `"azurerm_example" has been removed`,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteViolations(&buf, tc.violations, OutputFormatJSON); err != nil {
+				t.Fatalf("writing violations: %+v", err)
+			}
+
+			actual := make([]map[string]interface{}, 0)
+			if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+				t.Fatalf("unmarshaling %q: %+v", buf.String(), err)
+			}
+
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("expected %+v but got %+v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWriteViolationsSARIF(t *testing.T) {
+	type location struct {
+		ruleId string
+		name   string
+		kind   string
+	}
+
+	testCases := []struct {
+		name       string
+		violations []Violation
+		rules      []string
+		locations  []location
+	}{
+		{
+			name:       "none",
+			violations: []Violation{},
+			rules:      []string{},
+			locations:  []location{},
+		},
+		{
+			name:       "resources, data sources and properties",
+			violations: testViolations,
+			rules:      []string{"forceNewAdded", "propertyRemoved", "resourceRemoved"},
+			locations: []location{
+				{
+					ruleId: "resourceRemoved",
+					name:   "data.azurerm_example",
+					kind:   "dataSource",
+				},
+				{
+					ruleId: "forceNewAdded",
+					name:   "azurerm_example.network.subnet_id",
+					kind:   "property",
+				},
+				{
+					ruleId: "propertyRemoved",
+					name:   "azurerm_other.sku",
+					kind:   "property",
+				},
+				{
+					ruleId: "resourceRemoved",
+					name:   "azurerm_other_example",
+					kind:   "resource",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteViolations(&buf, tc.violations, OutputFormatSARIF); err != nil {
+				t.Fatalf("writing violations: %+v", err)
+			}
+
+			var actual sarifLog
+			if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+				t.Fatalf("unmarshaling %q: %+v", buf.String(), err)
+			}
+
+			if actual.Version != "2.1.0" || len(actual.Runs) != 1 {
+				t.Fatalf("expected a single SARIF 2.1.0 run but got %+v", actual)
+			}
+			run := actual.Runs[0]
+
+			rules := make([]string, 0)
+			for _, v := range run.Tool.Driver.Rules {
+				rules = append(rules, v.Id)
+			}
+			if !reflect.DeepEqual(tc.rules, rules) {
+				t.Fatalf("expected the rules %q but got %q", tc.rules, rules)
+			}
+
+			locations := make([]location, 0)
+			for _, v := range run.Results {
+				if v.Level != "error" || len(v.Locations) != 1 || len(v.Locations[0].LogicalLocations) != 1 {
+					t.Fatalf("expected an error with a single location but got %+v", v)
+				}
+				locations = append(locations, location{
+					ruleId: v.RuleId,
+					name:   v.Locations[0].LogicalLocations[0].FullyQualifiedName,
+					kind:   v.Locations[0].LogicalLocations[0].Kind,
+				})
+			}
+			if !reflect.DeepEqual(tc.locations, locations) {
+				t.Fatalf("expected the locations %+v but got %+v", tc.locations, locations)
+			}
+		})
+	}
+}
+
+func TestWriteViolationsUnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteViolations(&buf, testViolations, "xml"); err == nil {
+		t.Fatalf("expected an error for an unsupported format but didn't get one")
+	}
+}
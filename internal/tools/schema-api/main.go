@@ -26,6 +26,7 @@ func main() {
 	exportSchema := f.String("export", "", "export the schema to the given path/filename. Intended for use in the release process")
 	detectBreakingChanges := f.String("detect", "", "compare current schema to named dump.")
 	errorOnBreakingChange := f.Bool("error-on-violation", false, "should the detect mode exit with a non-zero error code. Defaults to `false`")
+	outputFormat := f.String("output-format", differ.OutputFormatText, "the format the detect mode should output violations in, one of `text`, `json` or `sarif`. Defaults to `text`")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		fmt.Printf("error parsing args: %+v", err)
@@ -53,13 +54,21 @@ func main() {
 	case pointer.From(detectBreakingChanges) != "":
 		{
 			d := differ.Differ{}
-			if violations := d.Diff(*detectBreakingChanges, *providerName); violations != nil {
+			violations, err := d.Diff(*detectBreakingChanges, *providerName)
+			if err != nil {
+				log.Fatalf("error detecting breaking changes: %+v", err)
+			}
+
+			if pointer.From(outputFormat) == differ.OutputFormatText {
 				for _, v := range violations {
 					log.Println(v)
 				}
-				if pointer.From(errorOnBreakingChange) {
-					os.Exit(1)
-				}
+			} else if err := differ.WriteViolations(os.Stdout, violations, *outputFormat); err != nil {
+				log.Fatalf("error writing violations: %+v", err)
+			}
+
+			if len(violations) > 0 && pointer.From(errorOnBreakingChange) {
+				os.Exit(1)
 			}
 
 			os.Exit(0)
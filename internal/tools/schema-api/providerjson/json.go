@@ -33,6 +33,9 @@ type SchemaJSON struct {
 	Elem        interface{} `json:"elem,omitempty"`
 	MaxItems    int         `json:"maxItems,omitempty"`
 	MinItems    int         `json:"minItems,omitempty"`
+
+	// PossibleValues are the values accepted by a `validation.StringInSlice` ValidateFunc, where used
+	PossibleValues []string `json:"possibleValues,omitempty"`
 }
 
 func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
@@ -52,7 +55,14 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 		b.MaxItems = int(max)
 	}
 	if min, ok := m["minItems"].(float64); ok {
-		b.MaxItems = int(min)
+		b.MinItems = int(min)
+	}
+	if values, ok := m["possibleValues"].([]interface{}); ok {
+		for _, v := range values {
+			if s, ok := v.(string); ok {
+				b.PossibleValues = append(b.PossibleValues, s)
+			}
+		}
 	}
 
 	if def, ok := m["default"]; ok && def != nil {
@@ -86,6 +96,9 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 type ResourceJSON struct {
 	Schema   map[string]SchemaJSON `json:"schema"`
 	Timeouts *ResourceTimeoutJSON  `json:"timeouts,omitempty"`
+
+	// IDFormat is an example of the Resource ID accepted by the Importer, where this can be determined
+	IDFormat string `json:"idFormat,omitempty"`
 }
 
 type ResourceTimeoutJSON struct {
@@ -21,6 +21,7 @@ func resourceFromRaw(input *schema.Resource) (*ResourceJSON, error) {
 		translatedSchema[k] = schemaFromRaw(s)
 	}
 	result.Schema = translatedSchema
+	result.IDFormat = idFormatFromImporter(input)
 
 	if input.Timeouts != nil {
 		timeouts := &ResourceTimeoutJSON{}
@@ -57,6 +58,8 @@ func schemaFromRaw(input *schema.Schema) SchemaJSON {
 		Elem:        decodeElem(input.Elem),
 		MaxItems:    input.MaxItems,
 		MinItems:    input.MinItems,
+
+		PossibleValues: possibleValuesFromValidateFunc(input),
 	}
 }
 
@@ -110,6 +113,12 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.MaxItems = int(t.(float64))
 	}
 
+	if t, ok := input["possibleValues"]; ok {
+		for _, v := range t.([]interface{}) {
+			result.PossibleValues = append(result.PossibleValues, v.(string))
+		}
+	}
+
 	return result
 }
 
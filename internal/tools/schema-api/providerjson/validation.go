@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// invalidValue is a value which shouldn't be accepted by any ValidateFunc or Resource ID parser, used to obtain the
+// validation error (and from that the accepted values/format) since these are otherwise opaque functions
+const invalidValue = "schema-api-invalid-value"
+
+var (
+	possibleValuesRegex = regexp.MustCompile(`to be one of \[(.*)\], got`)
+	quotedValueRegex    = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+)
+
+// possibleValuesFromValidateFunc returns the values accepted by the ValidateFunc for a String property, where this
+// uses `validation.StringInSlice` (either directly or via `validation.Any`/`validation.All`)
+func possibleValuesFromValidateFunc(input *schema.Schema) (out []string) {
+	if input.Type != schema.TypeString || input.ValidateFunc == nil {
+		return nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			// the ValidateFunc isn't able to handle this value, so we can't determine the possible values
+			out = nil
+		}
+	}()
+
+	_, errs := input.ValidateFunc(invalidValue, "")
+	for _, err := range errs {
+		match := possibleValuesRegex.FindStringSubmatch(err.Error())
+		if len(match) != 2 {
+			continue
+		}
+
+		for _, quoted := range quotedValueRegex.FindAllString(match[1], -1) {
+			if v, err := strconv.Unquote(quoted); err == nil {
+				out = append(out, v)
+			}
+		}
+	}
+
+	return out
+}
+
+// idFormatFromImporter returns the format of the Resource ID accepted by the Importer for this Resource - this is
+// determined from the error returned by the Resource ID parsers within `go-azure-helpers`/`go-azure-sdk` when
+// parsing an invalid Resource ID, as such this is only available for Resources using these parsers.
+func idFormatFromImporter(input *schema.Resource) string {
+	validateFunc, ok := pluginsdk.IDValidationFuncForImporter(input.Importer)
+	if !ok {
+		return ""
+	}
+
+	err := validateFunc(invalidValue)
+	if err == nil {
+		return ""
+	}
+
+	// the error contains an example of the expected Resource ID on the line following `Expected a ... ID that matched`
+	lines := strings.Split(err.Error(), "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "Expected a ") || !strings.Contains(line, " ID that matched") {
+			continue
+		}
+		for _, v := range lines[i+1:] {
+			if strings.HasPrefix(v, "> ") {
+				return strings.TrimPrefix(v, "> ")
+			}
+		}
+	}
+
+	return ""
+}
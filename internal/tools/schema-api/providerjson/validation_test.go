@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestPossibleValuesFromValidateFunc(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    *schema.Schema
+		expected []string
+	}{
+		{
+			name: "no validation",
+			input: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			expected: nil,
+		},
+		{
+			name: "not a string",
+			input: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+			},
+			expected: nil,
+		},
+		{
+			name: "not a list of possible values",
+			input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			expected: nil,
+		},
+		{
+			name: "possible values",
+			input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium"}, false),
+			},
+			expected: []string{"Basic", "Standard", "Premium"},
+		},
+		{
+			name: "possible values containing quotes and commas",
+			input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{`say "hello"`, "a, b", "[c]"}, false),
+			},
+			expected: []string{`say "hello"`, "a, b", "[c]"},
+		},
+		{
+			name: "possible values within validation.Any",
+			input: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.Any(
+					validation.StringInSlice([]string{"Enabled"}, false),
+					validation.StringInSlice([]string{"Disabled"}, false),
+				),
+			},
+			expected: []string{"Enabled", "Disabled"},
+		},
+		{
+			name: "possible values within validation.All",
+			input: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.All(
+					validation.StringIsNotEmpty,
+					validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+				),
+			},
+			expected: []string{"Enabled", "Disabled"},
+		},
+		{
+			name: "validate func which panics",
+			input: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: func(i interface{}, _ string) ([]string, []error) {
+					_ = i.(int)
+					return nil, nil
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := possibleValuesFromValidateFunc(tc.input)
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("expected %q but got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIDFormatFromImporter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		importer *schema.ResourceImporter
+		expected string
+	}{
+		{
+			name:     "no importer",
+			importer: nil,
+			expected: "",
+		},
+		{
+			name: "custom importer",
+			importer: &schema.ResourceImporter{
+				StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+					return nil, fmt.Errorf("parsing %q", d.Id())
+				},
+			},
+			expected: "",
+		},
+		{
+			name: "resource id parser",
+			importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+				_, err := commonids.ParseResourceGroupID(id)
+				return err
+			}),
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group",
+		},
+		{
+			name: "validation without an example",
+			importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+				return fmt.Errorf("%q isn't a valid ID", id)
+			}),
+			expected: "",
+		},
+		{
+			name: "validation accepting any value",
+			importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+				return nil
+			}),
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := idFormatFromImporter(&schema.Resource{
+				Importer: tc.importer,
+			})
+			if actual != tc.expected {
+				t.Fatalf("expected %q but got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package schema_rules
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
@@ -15,11 +16,47 @@ type defaultValueChange struct {
 
 var _ BreakingChangeRule = defaultValueChange{}
 
-// Check - Checks that an Optional or Required property is not updated to become Computed only
+func (defaultValueChange) ID() string {
+	return "defaultValueChange"
+}
+
+// Check - Checks that the Default value of a property has not changed, been added or been removed
 func (o defaultValueChange) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
-	if base.Default != current.Default {
-		return pointer.To(fmt.Sprintf("Cannot change property %q to Computed only", propertyName))
+	if base.Default == nil && current.Default == nil {
+		return nil
+	}
+
+	if base.Default == nil || current.Default == nil {
+		// new and removed properties are reported by other rules
+		if base.Type == "" || current.Type == "" {
+			return nil
+		}
+
+		if base.Default == nil {
+			return pointer.To(fmt.Sprintf("Cannot add a Default value of %q to property %q", formatDefaultValue(current.Default), propertyName))
+		}
+
+		return pointer.To(fmt.Sprintf("Cannot remove the Default value of %q from property %q", formatDefaultValue(base.Default), propertyName))
+	}
+
+	if baseValue, currentValue := formatDefaultValue(base.Default), formatDefaultValue(current.Default); baseValue != currentValue {
+		return pointer.To(fmt.Sprintf("Cannot change the Default value of property %q from %q to %q", propertyName, baseValue, currentValue))
 	}
 
 	return nil
 }
+
+// formatDefaultValue returns the Default value as a string - numeric values loaded from a schema dump are
+// float64's, so these are formatted consistently regardless of their type
+func formatDefaultValue(input interface{}) string {
+	switch v := input.(type) {
+	case int:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	return fmt.Sprintf("%v", input)
+}
@@ -156,3 +156,55 @@ func TestDefaultValueChange_Check(t *testing.T) {
 		t.Errorf("expected violation, but didn't get one")
 	}
 }
+
+func TestDefaultValueChange_CheckDefaultAddedOrRemoved(t *testing.T) {
+	testCases := []struct {
+		name      string
+		base      providerjson.SchemaJSON
+		current   providerjson.SchemaJSON
+		violation bool
+	}{
+		{
+			name:      "no default",
+			base:      providerjson.SchemaJSON{Type: providerjson.SchemaTypeString, Optional: true},
+			current:   providerjson.SchemaJSON{Type: providerjson.SchemaTypeString, Optional: true},
+			violation: false,
+		},
+		{
+			name:      "default added",
+			base:      providerjson.SchemaJSON{Type: providerjson.SchemaTypeString, Optional: true},
+			current:   providerjson.SchemaJSON{Type: providerjson.SchemaTypeString, Optional: true, Default: "foo"},
+			violation: true,
+		},
+		{
+			name:      "default removed",
+			base:      providerjson.SchemaJSON{Type: providerjson.SchemaTypeBool, Optional: true, Default: false},
+			current:   providerjson.SchemaJSON{Type: providerjson.SchemaTypeBool, Optional: true},
+			violation: true,
+		},
+		{
+			name:      "new property with a default",
+			base:      providerjson.SchemaJSON{},
+			current:   providerjson.SchemaJSON{Type: providerjson.SchemaTypeInt, Optional: true, Default: 1},
+			violation: false,
+		},
+		{
+			name:      "removed property with a default",
+			base:      providerjson.SchemaJSON{Type: providerjson.SchemaTypeInt, Optional: true, Default: 1},
+			current:   providerjson.SchemaJSON{},
+			violation: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := defaultValueChange{}.Check(tc.base, tc.current, "example")
+			if tc.violation && res == nil {
+				t.Fatalf("expected a violation, but didn't get one")
+			}
+			if !tc.violation && res != nil {
+				t.Fatalf("expected no violation, got %q", *res)
+			}
+		})
+	}
+}
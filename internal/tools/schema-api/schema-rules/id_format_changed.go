@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ ResourceBreakingChangeRule = idFormatChanged{}
+
+type idFormatChanged struct{}
+
+func (idFormatChanged) ID() string {
+	return "idFormatChanged"
+}
+
+// Check - Checks that the format of the Resource ID for an existing Resource hasn't changed, since this would
+// require existing Resources to be migrated via a State Upgrade. The format can only be determined for Resources
+// using the Resource ID parsers from `go-azure-helpers`/`go-azure-sdk`, so Resources without one are skipped.
+func (idFormatChanged) Check(base providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string {
+	if current == nil || base.IDFormat == "" || current.IDFormat == "" {
+		return nil
+	}
+
+	if base.IDFormat != current.IDFormat {
+		return pointer.To(fmt.Sprintf("the Resource ID format for %q has changed from %q to %q", resourceName, base.IDFormat, current.IDFormat))
+	}
+
+	return nil
+}
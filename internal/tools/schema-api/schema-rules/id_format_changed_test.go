@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var idFormatChangedBase = providerjson.ResourceJSON{
+	IDFormat: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.Example/widgets/widgetValue",
+}
+
+var idFormatChangedPasses = providerjson.ResourceJSON{
+	IDFormat: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.Example/widgets/widgetValue",
+}
+
+var idFormatChangedUnknown = providerjson.ResourceJSON{
+	IDFormat: "",
+}
+
+var idFormatChangedViolates = providerjson.ResourceJSON{
+	IDFormat: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.Example/gadgets/gadgetValue", // violation
+}
+
+func TestIdFormatChanged_Check(t *testing.T) {
+	data := idFormatChanged{}
+	if res := data.Check(idFormatChangedBase, &idFormatChangedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(idFormatChangedBase, &idFormatChangedUnknown, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(idFormatChangedBase, nil, ""); res != nil {
+		t.Errorf("expected no violation for a removed resource, got %+v", res)
+	}
+	if res := data.Check(idFormatChangedBase, &idFormatChangedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
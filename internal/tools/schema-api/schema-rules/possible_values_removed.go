@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = possibleValuesRemoved{}
+
+type possibleValuesRemoved struct{}
+
+func (possibleValuesRemoved) ID() string {
+	return "possibleValuesRemoved"
+}
+
+// Check - Checks that values accepted by an existing property are still accepted. Where the current property no
+// longer has a set of possible values (e.g. the validation has been relaxed) any value is accepted.
+func (possibleValuesRemoved) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if len(base.PossibleValues) == 0 || len(current.PossibleValues) == 0 {
+		return nil
+	}
+
+	currentValues := make(map[string]struct{}, len(current.PossibleValues))
+	for _, v := range current.PossibleValues {
+		currentValues[v] = struct{}{}
+	}
+
+	removed := make([]string, 0)
+	for _, v := range base.PossibleValues {
+		if _, ok := currentValues[v]; !ok {
+			removed = append(removed, v)
+		}
+	}
+
+	if len(removed) > 0 {
+		return pointer.To(fmt.Sprintf("Cannot remove the possible value(s) %q from property %q", strings.Join(removed, ", "), propertyName))
+	}
+
+	return nil
+}
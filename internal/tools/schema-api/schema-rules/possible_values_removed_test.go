@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var possibleValuesRemovedBase = providerjson.SchemaJSON{
+	Type:           "TypeString",
+	ConfigMode:     "",
+	Optional:       true,
+	Required:       false,
+	Default:        nil,
+	Description:    "",
+	Computed:       false,
+	ForceNew:       false,
+	Elem:           nil,
+	MaxItems:       0,
+	MinItems:       0,
+	PossibleValues: []string{"Basic", "Standard"},
+}
+
+var possibleValuesRemovedPasses = providerjson.SchemaJSON{
+	Type:           "TypeString",
+	ConfigMode:     "",
+	Optional:       true,
+	Required:       false,
+	Default:        nil,
+	Description:    "",
+	Computed:       false,
+	ForceNew:       false,
+	Elem:           nil,
+	MaxItems:       0,
+	MinItems:       0,
+	PossibleValues: []string{"Basic", "Premium", "Standard"},
+}
+
+var possibleValuesRemovedViolates = providerjson.SchemaJSON{
+	Type:           "TypeString",
+	ConfigMode:     "",
+	Optional:       true,
+	Required:       false,
+	Default:        nil,
+	Description:    "",
+	Computed:       false,
+	ForceNew:       false,
+	Elem:           nil,
+	MaxItems:       0,
+	MinItems:       0,
+	PossibleValues: []string{"Premium", "Standard"}, // violation
+}
+
+func TestPossibleValuesRemoved_Check(t *testing.T) {
+	data := possibleValuesRemoved{}
+	if res := data.Check(possibleValuesRemovedBase, possibleValuesRemovedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(possibleValuesRemovedBase, possibleValuesRemovedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ ResourceBreakingChangeRule = resourceRemoved{}
+
+type resourceRemoved struct{}
+
+func (resourceRemoved) ID() string {
+	return "resourceRemoved"
+}
+
+// Check - Checks that an existing Resource/Data Source has not been removed
+func (resourceRemoved) Check(_ providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string {
+	if current == nil {
+		return pointer.To(fmt.Sprintf("%q has been removed", resourceName))
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var resourceRemovedBase = providerjson.ResourceJSON{
+	Schema: map[string]providerjson.SchemaJSON{
+		"name": {
+			Type:     "TypeString",
+			Required: true,
+		},
+	},
+}
+
+func TestResourceRemoved_Check(t *testing.T) {
+	data := resourceRemoved{}
+	if res := data.Check(resourceRemovedBase, &resourceRemovedBase, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(resourceRemovedBase, nil, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
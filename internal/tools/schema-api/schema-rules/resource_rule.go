@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+
+// ResourceBreakingChangeRule is a BreakingChangeRule which applies to a Resource/Data Source as a whole, rather
+// than an individual property. `current` is nil when the Resource/Data Source no longer exists.
+type ResourceBreakingChangeRule interface {
+	ID() string
+	Check(base providerjson.ResourceJSON, current *providerjson.ResourceJSON, resourceName string) *string
+}
+
+var ResourceBreakingChangeRules = []ResourceBreakingChangeRule{
+	idFormatChanged{},
+	resourceRemoved{},
+}
+
+var ResourceBreakingChangeRulesDataSource = []ResourceBreakingChangeRule{
+	resourceRemoved{},
+}